@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/ibc-go/modules/capability"
 
+	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
 	transfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	ibccore "github.com/cosmos/ibc-go/v8/modules/core"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -47,6 +48,7 @@ func DefaultEncoding() testutil.TestEncodingConfig {
 		upgrade.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
 		ibccore.AppModuleBasic{},
 		ibctm.AppModuleBasic{},
 		ibcwasm.AppModuleBasic{},
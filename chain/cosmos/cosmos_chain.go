@@ -329,6 +329,11 @@ func (c *CosmosChain) SendIBCTransfer(
 	if err != nil {
 		return tx, fmt.Errorf("send ibc transfer: %w", err)
 	}
+	return c.sendPacketTx(txHash)
+}
+
+// sendPacketTx returns the transaction txHash along with the packet it sent.
+func (c *CosmosChain) sendPacketTx(txHash string) (tx ibc.Tx, _ error) {
 	txResp, err := c.GetTransaction(txHash)
 	if err != nil {
 		return tx, fmt.Errorf("failed to get transaction %s: %w", txHash, err)
@@ -488,6 +493,41 @@ func (c *CosmosChain) ExecuteContract(ctx context.Context, keyName string, contr
 	return c.getFullNode().ExecuteContract(ctx, keyName, contractAddress, message, extraExecTxArgs...)
 }
 
+// MigrateContract migrates a contract to the new code id with a migrate message. keyName must be the admin of the contract.
+func (c *CosmosChain) MigrateContract(ctx context.Context, keyName string, contractAddress string, newCodeID string, migrateMessage string, extraExecTxArgs ...string) (*types.TxResponse, error) {
+	return c.getFullNode().MigrateContract(ctx, keyName, contractAddress, newCodeID, migrateMessage, extraExecTxArgs...)
+}
+
+// UpdateContractAdmin sets the admin of a contract to newAdmin. keyName must be the current admin of the contract.
+func (c *CosmosChain) UpdateContractAdmin(ctx context.Context, keyName string, contractAddress string, newAdmin string, extraExecTxArgs ...string) (*types.TxResponse, error) {
+	return c.getFullNode().UpdateContractAdmin(ctx, keyName, contractAddress, newAdmin, extraExecTxArgs...)
+}
+
+// ClearContractAdmin removes the admin of a contract, after which it can no longer be migrated. keyName must be the current admin of the contract.
+func (c *CosmosChain) ClearContractAdmin(ctx context.Context, keyName string, contractAddress string, extraExecTxArgs ...string) (*types.TxResponse, error) {
+	return c.getFullNode().ClearContractAdmin(ctx, keyName, contractAddress, extraExecTxArgs...)
+}
+
+// InstantiateContract2 instantiates a contract at a predictable address derived from the code checksum, the creator and salt,
+// see PredictContractAddress. Returns the instantiated contract address.
+func (c *CosmosChain) InstantiateContract2(ctx context.Context, keyName string, codeID string, initMessage string, salt []byte, needsNoAdminFlag bool, extraExecTxArgs ...string) (string, error) {
+	return c.getFullNode().InstantiateContract2(ctx, keyName, codeID, initMessage, salt, needsNoAdminFlag, extraExecTxArgs...)
+}
+
+// PredictContractAddress returns the address of a contract instantiated with InstantiateContract2 from the code id by creator
+// with salt. initMessage is empty unless the address is derived from it with "--fix-msg".
+func (c *CosmosChain) PredictContractAddress(ctx context.Context, codeID string, creator string, salt []byte, initMessage string) (string, error) {
+	codeInfo, err := c.QueryCodeInfo(ctx, codeID)
+	if err != nil {
+		return "", fmt.Errorf("failed to query code info: %w", err)
+	}
+	creatorAddr, err := types.GetFromBech32(creator, c.Config().Bech32Prefix)
+	if err != nil {
+		return "", err
+	}
+	return types.Bech32ifyAddressBytes(c.Config().Bech32Prefix, BuildContractAddress2(codeInfo.DataHash, creatorAddr, salt, []byte(initMessage)))
+}
+
 // QueryContract performs a smart query, taking in a query struct and returning a error with the response struct populated.
 func (c *CosmosChain) QueryContract(ctx context.Context, contractAddress string, query any, response any) error {
 	return c.getFullNode().QueryContract(ctx, contractAddress, query, response)
@@ -498,6 +538,21 @@ func (c *CosmosChain) DumpContractState(ctx context.Context, contractAddress str
 	return c.getFullNode().DumpContractState(ctx, contractAddress, height)
 }
 
+// QueryContractInfo returns the metadata of a contract, e.g. its code id and admin.
+func (c *CosmosChain) QueryContractInfo(ctx context.Context, contractAddress string) (*ContractInfoResponse, error) {
+	return c.getFullNode().QueryContractInfo(ctx, contractAddress)
+}
+
+// QueryContractHistory returns the code history of a contract, from its instantiation through each migration.
+func (c *CosmosChain) QueryContractHistory(ctx context.Context, contractAddress string) ([]ContractCodeHistoryEntry, error) {
+	return c.getFullNode().QueryContractHistory(ctx, contractAddress)
+}
+
+// QueryCodeInfo returns the metadata of a code id, e.g. its checksum.
+func (c *CosmosChain) QueryCodeInfo(ctx context.Context, codeID string) (*CodeInfoResponse, error) {
+	return c.getFullNode().QueryCodeInfo(ctx, codeID)
+}
+
 // StoreClientContract takes a file path to a client smart contract and stores it on-chain. Returns the contracts code id.
 func (c *CosmosChain) StoreClientContract(ctx context.Context, keyName string, fileName string, extraExecTxArgs ...string) (string, error) {
 	return c.getFullNode().StoreClientContract(ctx, keyName, fileName, extraExecTxArgs...)
@@ -9,8 +9,12 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/internal/tendermint"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
 )
 
@@ -41,6 +45,56 @@ type CodeInfosResponse struct {
 	CodeInfos []CodeInfo `json:"code_infos"`
 }
 
+// ContractInfo is the metadata of a contract.
+type ContractInfo struct {
+	CodeID    string `json:"code_id"`
+	Creator   string `json:"creator"`
+	Admin     string `json:"admin"`
+	Label     string `json:"label"`
+	IBCPortID string `json:"ibc_port_id"`
+}
+
+type ContractInfoResponse struct {
+	Address      string       `json:"address"`
+	ContractInfo ContractInfo `json:"contract_info"`
+}
+
+// Operations of contract code history entries.
+const (
+	ContractCodeHistoryOperationInit    = "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT"
+	ContractCodeHistoryOperationMigrate = "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE"
+	ContractCodeHistoryOperationGenesis = "CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS"
+)
+
+// ContractCodeHistoryEntry is the instantiation or a migration of a contract, with its initialization or migrate message.
+type ContractCodeHistoryEntry struct {
+	Operation string `json:"operation"`
+	CodeID    string `json:"code_id"`
+	Updated   struct {
+		BlockHeight string `json:"block_height"`
+		TxIndex     string `json:"tx_index"`
+	} `json:"updated"`
+	Msg json.RawMessage `json:"msg"`
+}
+
+type ContractHistoryResponse struct {
+	Entries []ContractCodeHistoryEntry `json:"entries"`
+}
+
+type CodeInfoResponse struct {
+	CodeID   string         `json:"code_id"`
+	Creator  string         `json:"creator"`
+	DataHash bytes.HexBytes `json:"data_hash"`
+}
+
+// WasmEvent is an event emitted by a contract. Custom events of type "wasm-<type>" have Type <type>, the attributes
+// of the contract response are in an event with an empty Type.
+type WasmEvent struct {
+	Type            string
+	ContractAddress string
+	Attributes      map[string]string
+}
+
 // StoreContract takes a file path to smart contract and stores it on-chain. Returns the contracts code id.
 func (tn *ChainNode) StoreContract(ctx context.Context, keyName string, fileName string, extraExecTxArgs ...string) (string, error) {
 	_, file := filepath.Split(fileName)
@@ -113,7 +167,58 @@ func (tn *ChainNode) ExecuteContract(ctx context.Context, keyName string, contra
 	cmd := []string{"wasm", "execute", contractAddress, message}
 	cmd = append(cmd, extraExecTxArgs...)
 
-	txHash, err := tn.ExecTx(ctx, keyName, cmd...)
+	return tn.execContractTx(ctx, keyName, cmd...)
+}
+
+// MigrateContract migrates a contract to the new code id with a migrate message. keyName must be the admin of the contract.
+func (tn *ChainNode) MigrateContract(ctx context.Context, keyName string, contractAddress string, newCodeID string, migrateMessage string, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	cmd := []string{"wasm", "migrate", contractAddress, newCodeID, migrateMessage}
+	cmd = append(cmd, extraExecTxArgs...)
+
+	return tn.execContractTx(ctx, keyName, cmd...)
+}
+
+// UpdateContractAdmin sets the admin of a contract to newAdmin. keyName must be the current admin of the contract.
+func (tn *ChainNode) UpdateContractAdmin(ctx context.Context, keyName string, contractAddress string, newAdmin string, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	cmd := []string{"wasm", "set-contract-admin", contractAddress, newAdmin}
+	cmd = append(cmd, extraExecTxArgs...)
+
+	return tn.execContractTx(ctx, keyName, cmd...)
+}
+
+// ClearContractAdmin removes the admin of a contract, after which it can no longer be migrated. keyName must be the current admin of the contract.
+func (tn *ChainNode) ClearContractAdmin(ctx context.Context, keyName string, contractAddress string, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	cmd := []string{"wasm", "clear-contract-admin", contractAddress}
+	cmd = append(cmd, extraExecTxArgs...)
+
+	return tn.execContractTx(ctx, keyName, cmd...)
+}
+
+// InstantiateContract2 instantiates a contract at a predictable address derived from the code checksum, the creator and salt,
+// see BuildContractAddress2. Returns the instantiated contract address.
+// Pass "--fix-msg" in extraExecTxArgs to also derive the address from the initialization message.
+func (tn *ChainNode) InstantiateContract2(ctx context.Context, keyName string, codeID string, initMessage string, salt []byte, needsNoAdminFlag bool, extraExecTxArgs ...string) (string, error) {
+	command := []string{"wasm", "instantiate2", codeID, initMessage, hex.EncodeToString(salt), "--label", "wasm-contract"}
+	command = append(command, extraExecTxArgs...)
+	if needsNoAdminFlag {
+		command = append(command, "--no-admin")
+	}
+
+	txResp, err := tn.execContractTx(ctx, keyName, command...)
+	if err != nil {
+		return "", err
+	}
+
+	contractAddress, ok := tendermint.AttributeValue(txResp.Events, "instantiate", "_contract_address")
+	if !ok {
+		return "", fmt.Errorf("no contract address in transaction %s", txResp.TxHash)
+	}
+	return contractAddress, nil
+}
+
+// execContractTx executes a wasm transaction, returning an error with the response if it failed.
+func (tn *ChainNode) execContractTx(ctx context.Context, keyName string, command ...string) (*sdk.TxResponse, error) {
+	txHash, err := tn.ExecTx(ctx, keyName, command...)
 	if err != nil {
 		return &sdk.TxResponse{}, err
 	}
@@ -160,6 +265,48 @@ func (tn *ChainNode) QueryContract(ctx context.Context, contractAddress string,
 	return err
 }
 
+// QueryContractInfo returns the metadata of a contract, e.g. its code id and admin.
+func (tn *ChainNode) QueryContractInfo(ctx context.Context, contractAddress string) (*ContractInfoResponse, error) {
+	stdout, _, err := tn.ExecQuery(ctx, "wasm", "contract", contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(ContractInfoResponse)
+	if err := json.Unmarshal(stdout, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// QueryContractHistory returns the code history of a contract, from its instantiation through each migration.
+func (tn *ChainNode) QueryContractHistory(ctx context.Context, contractAddress string) ([]ContractCodeHistoryEntry, error) {
+	stdout, _, err := tn.ExecQuery(ctx, "wasm", "contract-history", contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := ContractHistoryResponse{}
+	if err := json.Unmarshal(stdout, &res); err != nil {
+		return nil, err
+	}
+	return res.Entries, nil
+}
+
+// QueryCodeInfo returns the metadata of a code id, e.g. its checksum.
+func (tn *ChainNode) QueryCodeInfo(ctx context.Context, codeID string) (*CodeInfoResponse, error) {
+	stdout, _, err := tn.ExecQuery(ctx, "wasm", "code-info", codeID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(CodeInfoResponse)
+	if err := json.Unmarshal(stdout, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // StoreClientContract takes a file path to a client smart contract and stores it on-chain. Returns the contracts code id.
 func (tn *ChainNode) StoreClientContract(ctx context.Context, keyName string, fileName string, extraExecTxArgs ...string) (string, error) {
 	content, err := os.ReadFile(fileName)
@@ -202,3 +349,45 @@ func (tn *ChainNode) DumpContractState(ctx context.Context, contractAddress stri
 	}
 	return res, nil
 }
+
+// WasmEvents returns the events emitted by contracts in a transaction, in order.
+func WasmEvents(txResp *sdk.TxResponse) []WasmEvent {
+	var events []WasmEvent
+	for _, event := range txResp.Events {
+		if event.Type != wasmEventType && !strings.HasPrefix(event.Type, wasmEventType+"-") {
+			continue
+		}
+
+		wasmEvent := WasmEvent{
+			Type:       strings.TrimPrefix(strings.TrimPrefix(event.Type, wasmEventType), "-"),
+			Attributes: make(map[string]string, len(event.Attributes)),
+		}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case "_contract_address":
+				wasmEvent.ContractAddress = attr.Value
+			case "msg_index":
+				// Added by the SDK to the events of each message.
+			default:
+				wasmEvent.Attributes[attr.Key] = attr.Value
+			}
+		}
+		events = append(events, wasmEvent)
+	}
+	return events
+}
+
+const wasmEventType = "wasm"
+
+// BuildContractAddress2 returns the address of a contract instantiated with InstantiateContract2 from code with the
+// checksum by creator with salt. initMessage is empty unless the address is derived from it with "--fix-msg".
+func BuildContractAddress2(checksum []byte, creator sdk.AccAddress, salt []byte, initMessage []byte) sdk.AccAddress {
+	var key []byte
+	for _, bz := range [][]byte{checksum, creator, salt, initMessage} {
+		// Each part is prefixed with its big endian length.
+		key = append(key, sdk.Uint64ToBigEndian(uint64(len(bz)))...)
+		key = append(key, bz...)
+	}
+	// Contract addresses are 32 byte addresses of the wasm module.
+	return address.Module("wasm", key)[:32]
+}
@@ -0,0 +1,66 @@
+package cosmos
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContractAddress2(t *testing.T) {
+	// Test vectors of wasmd, generated with cosmjs.
+	checksum, err := hex.DecodeString("13a1fc994cc6d1c81b746ee0c0ff6f90043875e0bf1d9be6b7d779fc978dc2a5")
+	require.NoError(t, err)
+	creator, err := sdk.GetFromBech32("purple1nxvenxve42424242hwamhwamenxvenxvhxf2py", "purple")
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		initMessage string
+		want        string
+	}{
+		{"", "purple1t6r960j945lfv8mhl4mage2rg97w63xeynwrupum2s2l7em4lprs9ce5hk"},
+		{"{}", "purple1px25n9sgj3a99q0zcl4awx7my6s6mxqegmdd2lmvf5lwxh080q6suttktr"},
+		{`{"some":123,"structure":{"nested":["ok",true]}}`, "purple1svexu428ywc4htrxfn4tezjcsl38qqata8aany4033auafr529ns4v254c"},
+	} {
+		got, err := sdk.Bech32ifyAddressBytes("purple", BuildContractAddress2(checksum, creator, []byte("a"), []byte(tt.initMessage)))
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+}
+
+func TestWasmEvents(t *testing.T) {
+	txResp := &sdk.TxResponse{Events: []abcitypes.Event{
+		{Type: "message", Attributes: []abcitypes.EventAttribute{{Key: "action", Value: "/cosmwasm.wasm.v1.MsgExecuteContract"}}},
+		{Type: "wasm", Attributes: []abcitypes.EventAttribute{
+			{Key: "_contract_address", Value: "wasm1a"},
+			{Key: "action", Value: "increment"},
+			{Key: "msg_index", Value: "0"},
+		}},
+		{Type: "wasm-counter", Attributes: []abcitypes.EventAttribute{
+			{Key: "_contract_address", Value: "wasm1a"},
+			{Key: "count", Value: "2"},
+		}},
+		{Type: "wasmer", Attributes: []abcitypes.EventAttribute{{Key: "count", Value: "3"}}},
+	}}
+
+	require.Equal(t, []WasmEvent{
+		{Type: "", ContractAddress: "wasm1a", Attributes: map[string]string{"action": "increment"}},
+		{Type: "counter", ContractAddress: "wasm1a", Attributes: map[string]string{"count": "2"}},
+	}, WasmEvents(txResp))
+}
+
+func TestContractHistoryResponse(t *testing.T) {
+	const stdout = `{"entries":[{"operation":"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT","code_id":"1","updated":{"block_height":"10","tx_index":"0"},"msg":{"count":0}},{"operation":"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE","code_id":"2","updated":{"block_height":"20","tx_index":"1"},"msg":{}}],"pagination":{"next_key":null,"total":"0"}}`
+
+	var res ContractHistoryResponse
+	require.NoError(t, json.Unmarshal([]byte(stdout), &res))
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, ContractCodeHistoryOperationInit, res.Entries[0].Operation)
+	require.JSONEq(t, `{"count":0}`, string(res.Entries[0].Msg))
+	require.Equal(t, ContractCodeHistoryOperationMigrate, res.Entries[1].Operation)
+	require.Equal(t, "2", res.Entries[1].CodeID)
+	require.Equal(t, "20", res.Entries[1].Updated.BlockHeight)
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
@@ -113,14 +114,9 @@ func (tn *ChainNode) ParamChangeProposal(ctx context.Context, keyName string, pr
 // The proposer field should only be set for IBC-Go v8 / SDK v50 chains.
 func (c *CosmosChain) BuildProposal(messages []ProtoMessage, title, summary, metadata, depositStr, proposer string, expedited bool) (TxProposalv1, error) {
 	var propType TxProposalv1
-	rawMsgs := make([]json.RawMessage, len(messages))
-
-	for i, msg := range messages {
-		msg, err := c.Config().EncodingConfig.Codec.MarshalInterfaceJSON(msg)
-		if err != nil {
-			return propType, err
-		}
-		rawMsgs[i] = msg
+	rawMsgs, err := c.proposalMessagesJSON(messages...)
+	if err != nil {
+		return propType, err
 	}
 
 	propType = TxProposalv1{
@@ -140,6 +136,55 @@ func (c *CosmosChain) BuildProposal(messages []ProtoMessage, title, summary, met
 	return propType, nil
 }
 
+// proposalMessagesJSON encodes the messages of a gov v1 proposal.
+func (c *CosmosChain) proposalMessagesJSON(messages ...ProtoMessage) ([]json.RawMessage, error) {
+	rawMsgs := make([]json.RawMessage, len(messages))
+	for i, msg := range messages {
+		bz, err := c.Config().EncodingConfig.Codec.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		rawMsgs[i] = bz
+	}
+	return rawMsgs, nil
+}
+
+// submitAndVoteProposal submits a gov v1 proposal from keyName with the messages returned by msgs for the
+// governance module authority, and votes yes on it with all validators.
+func (c *CosmosChain) submitAndVoteProposal(ctx context.Context, keyName string, msgs func(authority string) ([]json.RawMessage, error), title, summary, deposit string) (TxProposal, error) {
+	authority, err := c.GetGovernanceAddress(ctx)
+	if err != nil {
+		return TxProposal{}, fmt.Errorf("failed to get governance address: %w", err)
+	}
+	proposer, err := c.GetAddress(ctx, keyName)
+	if err != nil {
+		return TxProposal{}, err
+	}
+	proposerAddr, err := sdk.Bech32ifyAddressBytes(c.Config().Bech32Prefix, proposer)
+	if err != nil {
+		return TxProposal{}, err
+	}
+
+	rawMsgs, err := msgs(authority)
+	if err != nil {
+		return TxProposal{}, err
+	}
+	prop, err := c.BuildProposal(nil, title, summary, "", deposit, proposerAddr, false)
+	if err != nil {
+		return TxProposal{}, err
+	}
+	prop.Messages = rawMsgs
+
+	tx, err := c.SubmitProposal(ctx, keyName, prop)
+	if err != nil {
+		return TxProposal{}, err
+	}
+	if err := c.VoteOnProposalAllValidators(ctx, tx.ProposalID, ProposalVoteYes); err != nil {
+		return TxProposal{}, fmt.Errorf("failed to vote on proposal %s: %w", tx.ProposalID, err)
+	}
+	return tx, nil
+}
+
 // GovQueryProposal returns the state and details of a v1beta1 governance proposal.
 func (c *CosmosChain) GovQueryProposal(ctx context.Context, proposalID uint64) (*govv1beta1.Proposal, error) {
 	res, err := govv1beta1.NewQueryClient(c.GetNode().GrpcConn).Proposal(ctx, &govv1beta1.QueryProposalRequest{ProposalId: proposalID})
@@ -0,0 +1,59 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ChanUpgradeInit submits a governance proposal initializing the upgrade of the channel to fields, and votes yes
+// on it with all validators. Upgrades are only initialized once the proposal passes, which can be awaited with
+// PollForProposalStatusV1. The relayer then completes the handshake through ibc.Relayer.UpgradeChannel.
+// Requires ibc-go v8.1 or later.
+func (c *CosmosChain) ChanUpgradeInit(ctx context.Context, keyName, portID, channelID string, fields chantypes.UpgradeFields, deposit string) (TxProposal, error) {
+	return c.submitAndVoteProposal(ctx, keyName, func(authority string) ([]json.RawMessage, error) {
+		return c.proposalMessagesJSON(chantypes.NewMsgChannelUpgradeInit(portID, channelID, fields, authority))
+	},
+		fmt.Sprintf("Upgrade %s/%s", portID, channelID),
+		fmt.Sprintf("Upgrade channel %s on port %s to version %s", channelID, portID, fields.Version),
+		deposit,
+	)
+}
+
+// IbcChannelQuery returns the channel end of the channel.
+func (c *CosmosChain) IbcChannelQuery(ctx context.Context, portID, channelID string) (*chantypes.Channel, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Channel(ctx, &chantypes.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Channel, nil
+}
+
+// IbcChannelQueryUpgrade returns the upgrade in progress on the channel.
+func (c *CosmosChain) IbcChannelQueryUpgrade(ctx context.Context, portID, channelID string) (*chantypes.Upgrade, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).Upgrade(ctx, &chantypes.QueryUpgradeRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Upgrade, nil
+}
+
+// IbcChannelQueryUpgradeError returns the error receipt of the last failed upgrade of the channel.
+func (c *CosmosChain) IbcChannelQueryUpgradeError(ctx context.Context, portID, channelID string) (*chantypes.ErrorReceipt, error) {
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).UpgradeError(ctx, &chantypes.QueryUpgradeErrorRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.ErrorReceipt, nil
+}
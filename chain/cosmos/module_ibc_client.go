@@ -0,0 +1,266 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+)
+
+// IbcClientSubmitMisbehaviour submits misbehaviour of the counterparty chain to the client, freezing it.
+func (tn *ChainNode) IbcClientSubmitMisbehaviour(ctx context.Context, keyName, clientID string, misbehaviour *ibctm.Misbehaviour) error {
+	bz, err := tn.Chain.Config().EncodingConfig.Codec.MarshalInterfaceJSON(misbehaviour)
+	if err != nil {
+		return fmt.Errorf("failed to marshal misbehaviour: %w", err)
+	}
+
+	file := "misbehaviour.json"
+	if err := tn.WriteFile(ctx, bz, file); err != nil {
+		return fmt.Errorf("failed to write misbehaviour file: %w", err)
+	}
+
+	_, err = tn.ExecTx(ctx, keyName,
+		"ibc", "client", "update", clientID, path.Join(tn.HomeDir(), file),
+	)
+	return err
+}
+
+// RecoverClient submits a governance proposal recovering the expired or frozen subject client with the state of
+// the active substitute client, and votes yes on it with all validators. The client is recovered once the proposal
+// passes, which can be awaited with PollForProposalStatusV1.
+func (c *CosmosChain) RecoverClient(ctx context.Context, keyName, subjectClientID, substituteClientID, deposit string) (TxProposal, error) {
+	return c.submitAndVoteProposal(ctx, keyName, func(authority string) ([]json.RawMessage, error) {
+		return c.proposalMessagesJSON(clienttypes.NewMsgRecoverClient(authority, subjectClientID, substituteClientID))
+	},
+		fmt.Sprintf("Recover %s", subjectClientID),
+		fmt.Sprintf("Recover client %s with substitute client %s", subjectClientID, substituteClientID),
+		deposit,
+	)
+}
+
+// IbcClientQueryStatus returns the status of the client, one of ibc.ClientStatusActive, ibc.ClientStatusExpired
+// or ibc.ClientStatusFrozen.
+func (c *CosmosChain) IbcClientQueryStatus(ctx context.Context, clientID string) (string, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Status, nil
+}
+
+// IbcClientQueryState returns the state of the tendermint client.
+func (c *CosmosChain) IbcClientQueryState(ctx context.Context, clientID string) (*ibctm.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(c.GetNode().GrpcConn).ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var clientState ibctm.ClientState
+	if err := clientState.Unmarshal(res.ClientState.Value); err != nil {
+		return nil, fmt.Errorf("client %s is not a tendermint client: %w", clientID, err)
+	}
+	return &clientState, nil
+}
+
+// ExpireClient pauses the running relayer until the client hosted on the chain, created with a short trusting
+// period (see ibc.CreateClientOptions), expires. The relayer is resumed before returning.
+func (c *CosmosChain) ExpireClient(ctx context.Context, r ibc.Relayer, clientID string) error {
+	clientState, err := c.IbcClientQueryState(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.PauseRelayer(ctx); err != nil {
+		return fmt.Errorf("failed to pause relayer: %w", err)
+	}
+
+	waitErr := testutil.WaitForCondition(clientState.TrustingPeriod+time.Minute, time.Second, func() (bool, error) {
+		status, err := c.IbcClientQueryStatus(ctx, clientID)
+		if err != nil {
+			return false, err
+		}
+		return status == ibc.ClientStatusExpired, nil
+	})
+
+	if err := r.ResumeRelayer(ctx); err != nil {
+		return fmt.Errorf("failed to resume relayer: %w", err)
+	}
+	if waitErr != nil {
+		return fmt.Errorf("client %s did not expire: %w", clientID, waitErr)
+	}
+	return nil
+}
+
+// Misbehaviour returns misbehaviour of the chain for a client tracking it on a counterparty chain: the header at
+// the chain's latest committed height, and a conflicting header double signed with the keys of all the chain's
+// validators. trustedHeight must be a height of a consensus state of the client, lower than the chain's height.
+func (c *CosmosChain) Misbehaviour(ctx context.Context, trustedHeight clienttypes.Height) (*ibctm.Misbehaviour, error) {
+	node := c.GetNode()
+
+	latest, err := c.Height(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The commit of the latest block is only included in the next block.
+	height := latest - 1
+
+	commit, err := node.Client.Commit(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit at height %d: %w", height, err)
+	}
+	valSet, err := c.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	trustedValHeight := int64(trustedHeight.RevisionHeight) + 1
+	trustedValSet, err := c.validatorSet(ctx, trustedValHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	privVals := make([]cmttypes.PrivValidator, len(c.Validators))
+	for i, val := range c.Validators {
+		bz, err := val.ReadFile(ctx, "config/priv_validator_key.json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read validator key: %w", err)
+		}
+		var keyFile PrivValidatorKeyFile
+		if err := json.Unmarshal(bz, &keyFile); err != nil {
+			return nil, fmt.Errorf("failed to parse validator key: %w", err)
+		}
+		privKey, err := base64.StdEncoding.DecodeString(keyFile.PrivKey.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode validator key: %w", err)
+		}
+		privVals[i] = cmttypes.NewMockPVWithParams(ed25519.PrivKey(privKey), false, false)
+	}
+
+	conflicting, err := conflictingSignedHeader(c.Config().ChainID, &commit.SignedHeader, valSet, privVals)
+	if err != nil {
+		return nil, err
+	}
+
+	header := func(sh *cmttypes.SignedHeader) (*ibctm.Header, error) {
+		valSetProto, err := valSet.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		trustedValSetProto, err := trustedValSet.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		shProto := sh.ToProto()
+		return &ibctm.Header{
+			SignedHeader:      shProto,
+			ValidatorSet:      valSetProto,
+			TrustedHeight:     trustedHeight,
+			TrustedValidators: trustedValSetProto,
+		}, nil
+	}
+
+	header1, err := header(&commit.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+	header2, err := header(conflicting)
+	if err != nil {
+		return nil, err
+	}
+	return &ibctm.Misbehaviour{Header1: header1, Header2: header2}, nil
+}
+
+// validatorSet returns the validator set of the chain at height.
+func (c *CosmosChain) validatorSet(ctx context.Context, height int64) (*cmttypes.ValidatorSet, error) {
+	var (
+		vals    []*cmttypes.Validator
+		page    = 1
+		perPage = 100
+	)
+	for {
+		res, err := c.GetNode().Client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validators at height %d: %w", height, err)
+		}
+		vals = append(vals, res.Validators...)
+		if len(vals) >= res.Total {
+			break
+		}
+		page++
+	}
+	return cmttypes.NewValidatorSet(vals), nil
+}
+
+// conflictingSignedHeader returns a header at the same height as sh with a different app hash,
+// committed with the signatures of privVals.
+func conflictingSignedHeader(chainID string, sh *cmttypes.SignedHeader, valSet *cmttypes.ValidatorSet, privVals []cmttypes.PrivValidator) (*cmttypes.SignedHeader, error) {
+	header := *sh.Header
+	header.AppHash = tmhash.Sum(append([]byte("misbehaviour"), header.AppHash...))
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: sh.Commit.BlockID.PartSetHeader,
+	}
+
+	signers := make(map[string]cmttypes.PrivValidator, len(privVals))
+	for _, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		signers[string(pubKey.Address())] = pv
+	}
+
+	sigs := make([]cmttypes.CommitSig, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		pv, ok := signers[string(val.Address)]
+		if !ok {
+			sigs[i] = cmttypes.NewCommitSigAbsent()
+			continue
+		}
+
+		vote := &cmttypes.Vote{
+			Type:             cmtproto.PrecommitType,
+			Height:           header.Height,
+			Round:            sh.Commit.Round,
+			BlockID:          blockID,
+			Timestamp:        header.Time,
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+		}
+		voteProto := vote.ToProto()
+		if err := pv.SignVote(chainID, voteProto); err != nil {
+			return nil, fmt.Errorf("failed to sign conflicting header: %w", err)
+		}
+		sigs[i] = cmttypes.CommitSig{
+			BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        vote.Timestamp,
+			Signature:        voteProto.Signature,
+		}
+	}
+
+	return &cmttypes.SignedHeader{
+		Header: &header,
+		Commit: &cmttypes.Commit{
+			Height:     header.Height,
+			Round:      sh.Commit.Round,
+			BlockID:    blockID,
+			Signatures: sigs,
+		},
+	}, nil
+}
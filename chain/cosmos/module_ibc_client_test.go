@@ -0,0 +1,51 @@
+package cosmos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConflictingSignedHeader(t *testing.T) {
+	const chainID = "chain-1"
+
+	valSet, privVals := cmttypes.RandValidatorSet(4, 10)
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            chainID,
+		Height:             10,
+		Time:               time.Now().UTC(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            tmhash.Sum([]byte("app")),
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+	sh := &cmttypes.SignedHeader{
+		Header: header,
+		Commit: &cmttypes.Commit{
+			Height: header.Height,
+			BlockID: cmttypes.BlockID{
+				Hash:          header.Hash(),
+				PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+			},
+		},
+	}
+
+	conflicting, err := conflictingSignedHeader(chainID, sh, valSet, privVals)
+	require.NoError(t, err)
+
+	require.Equal(t, header.Height, conflicting.Height)
+	require.NotEqual(t, header.Hash(), conflicting.Hash())
+	require.NoError(t, conflicting.ValidateBasic(chainID))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, conflicting.Commit.BlockID, conflicting.Height, conflicting.Commit))
+
+	// Validators without a key are absent from the commit.
+	conflicting, err = conflictingSignedHeader(chainID, sh, valSet, privVals[:1])
+	require.NoError(t, err)
+	require.Error(t, valSet.VerifyCommitLight(chainID, conflicting.Commit.BlockID, conflicting.Height, conflicting.Commit))
+}
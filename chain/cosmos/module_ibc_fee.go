@@ -0,0 +1,86 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+// IbcFeeRegisterPayee registers the address receiving the ack and timeout fees earned by the relayer on a channel.
+// keyName must be the key of the relayer.
+func (tn *ChainNode) IbcFeeRegisterPayee(ctx context.Context, keyName, portID, channelID, relayerAddr, payee string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"ibc-fee", "register-payee", portID, channelID, relayerAddr, payee,
+	)
+	return err
+}
+
+// IbcFeeRegisterCounterpartyPayee registers the address on the counterparty chain receiving the recv fees earned
+// by the relayer, when it relays packets of the channel to this chain. keyName must be the key of the relayer.
+func (tn *ChainNode) IbcFeeRegisterCounterpartyPayee(ctx context.Context, keyName, portID, channelID, relayerAddr, counterpartyPayee string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"ibc-fee", "register-counterparty-payee", portID, channelID, relayerAddr, counterpartyPayee,
+	)
+	return err
+}
+
+// IbcFeePayPacketFee escrows fees incentivizing the relaying of a packet which was already sent.
+// The fees are coin strings such as "100uatom"; unused fees are refunded to the payer.
+func (tn *ChainNode) IbcFeePayPacketFee(ctx context.Context, keyName, portID, channelID string, sequence uint64, recvFee, ackFee, timeoutFee string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"ibc-fee", "pay-packet-fee", portID, channelID, fmt.Sprint(sequence),
+		"--recv-fee", recvFee,
+		"--ack-fee", ackFee,
+		"--timeout-fee", timeoutFee,
+	)
+	return err
+}
+
+// IbcFeeQueryFeeEnabledChannel returns whether the channel uses the fee middleware.
+func (c *CosmosChain) IbcFeeQueryFeeEnabledChannel(ctx context.Context, portID, channelID string) (bool, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).FeeEnabledChannel(ctx, &feetypes.QueryFeeEnabledChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.FeeEnabled, nil
+}
+
+// IbcFeeQueryIncentivizedPackets returns the packets of the channel with unpaid fees.
+func (c *CosmosChain) IbcFeeQueryIncentivizedPackets(ctx context.Context, portID, channelID string) ([]*feetypes.IdentifiedPacketFees, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).IncentivizedPacketsForChannel(ctx, &feetypes.QueryIncentivizedPacketsForChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.IncentivizedPackets, nil
+}
+
+// IbcFeeQueryPayee returns the payee registered for the relayer on the channel.
+func (c *CosmosChain) IbcFeeQueryPayee(ctx context.Context, channelID, relayerAddr string) (string, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).Payee(ctx, &feetypes.QueryPayeeRequest{
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.PayeeAddress, nil
+}
+
+// IbcFeeQueryCounterpartyPayee returns the counterparty payee registered for the relayer on the channel.
+func (c *CosmosChain) IbcFeeQueryCounterpartyPayee(ctx context.Context, channelID, relayerAddr string) (string, error) {
+	res, err := feetypes.NewQueryClient(c.GetNode().GrpcConn).CounterpartyPayee(ctx, &feetypes.QueryCounterpartyPayeeRequest{
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.CounterpartyPayee, nil
+}
@@ -0,0 +1,195 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// ICAControllerRegisterAccount registers an interchain account owned by keyName on the host chain at the other end
+// of the connection. An empty version negotiates the default ICS-27 version. Registering again once the channel of
+// the account is closed, e.g. after a packet timed out on an ordered channel, reopens a channel for the account.
+// The handshake is completed by the relayer. Requires ibc-go v8.1 or later for unordered channels.
+func (tn *ChainNode) ICAControllerRegisterAccount(ctx context.Context, keyName, connectionID, version string, ordering chantypes.Order) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"interchain-accounts", "controller", "register", connectionID,
+		"--version", version,
+		"--ordering", ordering.String(),
+	)
+	return err
+}
+
+// ICAControllerSendTx sends the packet data to the interchain account owned by keyName over the connection,
+// timing out after timeout. Returns the transaction hash.
+func (tn *ChainNode) ICAControllerSendTx(ctx context.Context, keyName, connectionID string, packetData icatypes.InterchainAccountPacketData, timeout time.Duration) (string, error) {
+	bz, err := tn.Chain.Config().EncodingConfig.Codec.MarshalJSON(&packetData)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal packet data: %w", err)
+	}
+
+	return tn.ExecTx(ctx, keyName,
+		"interchain-accounts", "controller", "send-tx", connectionID, string(bz),
+		"--relative-packet-timeout", strconv.FormatInt(timeout.Nanoseconds(), 10),
+	)
+}
+
+// ICAPacketData returns packet data executing msgs with the interchain account, proto3 encoded.
+func (c *CosmosChain) ICAPacketData(memo string, msgs ...sdk.Msg) (icatypes.InterchainAccountPacketData, error) {
+	protoMsgs := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+	}
+
+	data, err := icatypes.SerializeCosmosTx(c.Config().EncodingConfig.Codec, protoMsgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, fmt.Errorf("failed to serialize messages: %w", err)
+	}
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}, nil
+}
+
+// ICASendTx sends msgs to be executed by the interchain account owned by keyName over the connection, timing out
+// after timeout. The returned transaction includes the packet, whose acknowledgement can be awaited with
+// testutil.PollForAck and decoded with ICAAckResults.
+func (c *CosmosChain) ICASendTx(ctx context.Context, keyName, connectionID string, timeout time.Duration, msgs ...sdk.Msg) (ibc.Tx, error) {
+	packetData, err := c.ICAPacketData("", msgs...)
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	txHash, err := c.getFullNode().ICAControllerSendTx(ctx, keyName, connectionID, packetData, timeout)
+	if err != nil {
+		return ibc.Tx{}, fmt.Errorf("send interchain account tx: %w", err)
+	}
+	return c.sendPacketTx(txHash)
+}
+
+// ICAAckResults returns the responses of the messages executed by the interchain account on the host chain,
+// decoded from the acknowledgement of the packet. Returns an error for error acknowledgements.
+func (c *CosmosChain) ICAAckResults(ack ibc.PacketAcknowledgement) ([]proto.Message, error) {
+	cfg := c.Config().EncodingConfig
+	return icaAckResults(cfg.Codec, cfg.InterfaceRegistry, ack.Acknowledgement)
+}
+
+func icaAckResults(cdc codec.Codec, registry codectypes.InterfaceRegistry, bz []byte) ([]proto.Message, error) {
+	var ack chantypes.Acknowledgement
+	if err := chantypes.SubModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal acknowledgement: %w", err)
+	}
+	if !ack.Success() {
+		return nil, fmt.Errorf("error acknowledgement: %s", ack.GetError())
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := cdc.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tx result: %w", err)
+	}
+
+	results := make([]proto.Message, len(txMsgData.MsgResponses))
+	for i, res := range txMsgData.MsgResponses {
+		msg, err := registry.Resolve(res.TypeUrl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %w", res.TypeUrl, err)
+		}
+		if err := cdc.Unmarshal(res.Value, msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", res.TypeUrl, err)
+		}
+		results[i] = msg
+	}
+	return results, nil
+}
+
+// ICAHostUpdateParams submits a governance proposal updating the interchain accounts host params, e.g. the
+// messages allowed to be executed by interchain accounts, and votes yes on it with all validators.
+// Use ICAHostAllowMessagesGenesis to configure the allowlist at genesis instead.
+func (c *CosmosChain) ICAHostUpdateParams(ctx context.Context, keyName string, params icahosttypes.Params, deposit string) (TxProposal, error) {
+	return c.submitAndVoteProposal(ctx, keyName, func(authority string) ([]json.RawMessage, error) {
+		return c.proposalMessagesJSON(icahosttypes.NewMsgUpdateParams(authority, params))
+	},
+		"Update interchain accounts host params",
+		fmt.Sprintf("Set host enabled to %t and allow messages %v", params.HostEnabled, params.AllowMessages),
+		deposit,
+	)
+}
+
+// ICAHostAllowMessagesGenesis returns the genesis of the interchain accounts host allowing msgTypeURLs,
+// e.g. "/cosmos.bank.v1beta1.MsgSend", or all messages with "*". See ModifyGenesis.
+func ICAHostAllowMessagesGenesis(msgTypeURLs ...string) []GenesisKV {
+	return []GenesisKV{
+		NewGenesisKV("app_state.interchainaccounts.host_genesis_state.params.host_enabled", true),
+		NewGenesisKV("app_state.interchainaccounts.host_genesis_state.params.allow_messages", msgTypeURLs),
+	}
+}
+
+// ICAQueryAccount returns the address of the interchain account owned by owner over the connection.
+func (c *CosmosChain) ICAQueryAccount(ctx context.Context, owner, connectionID string) (string, error) {
+	res, err := icacontrollertypes.NewQueryClient(c.GetNode().GrpcConn).InterchainAccount(ctx, &icacontrollertypes.QueryInterchainAccountRequest{
+		Owner:        owner,
+		ConnectionId: connectionID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Address, nil
+}
+
+// ICAQueryChannel returns the most recent channel of the interchain account owned by owner over the connection,
+// open or not.
+func (c *CosmosChain) ICAQueryChannel(ctx context.Context, owner, connectionID string) (*chantypes.IdentifiedChannel, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := chantypes.NewQueryClient(c.GetNode().GrpcConn).ConnectionChannels(ctx, &chantypes.QueryConnectionChannelsRequest{
+		Connection: connectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latest    *chantypes.IdentifiedChannel
+		latestSeq uint64
+	)
+	for _, channel := range res.Channels {
+		if channel.PortId != portID {
+			continue
+		}
+		seq, err := chantypes.ParseChannelSequence(channel.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || seq > latestSeq {
+			latest, latestSeq = channel, seq
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no interchain account channel for %s on %s", owner, connectionID)
+	}
+	return latest, nil
+}
+
+// ICAHostQueryParams returns the interchain accounts host params.
+func (c *CosmosChain) ICAHostQueryParams(ctx context.Context) (*icahosttypes.Params, error) {
+	res, err := icahosttypes.NewQueryClient(c.GetNode().GrpcConn).Params(ctx, &icahosttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Params, nil
+}
@@ -0,0 +1,31 @@
+package cosmos
+
+import (
+	"errors"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestICAAckResults(t *testing.T) {
+	cfg := DefaultEncoding()
+
+	res, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	require.NoError(t, err)
+	bz, err := cfg.Codec.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{res}})
+	require.NoError(t, err)
+
+	ack := chantypes.NewResultAcknowledgement(bz)
+	results, err := icaAckResults(cfg.Codec, cfg.InterfaceRegistry, ack.Acknowledgement())
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.IsType(t, &banktypes.MsgSendResponse{}, results[0])
+
+	ack = chantypes.NewErrorAcknowledgement(errors.New("failed"))
+	_, err = icaAckResults(cfg.Codec, cfg.InterfaceRegistry, ack.Acknowledgement())
+	require.ErrorContains(t, err, "error acknowledgement")
+}
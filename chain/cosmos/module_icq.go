@@ -0,0 +1,180 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// ICQHostPort is the port of the async-icq host module.
+	ICQHostPort = "icqhost"
+	// ICQVersion is the version of interchain query channels.
+	ICQVersion = "icq-1"
+)
+
+// ICQHostGenesis returns the genesis of the async-icq host allowing the queries, e.g.
+// "/cosmos.bank.v1beta1.Query/AllBalances". See ModifyGenesis.
+func ICQHostGenesis(allowQueries ...string) []GenesisKV {
+	return []GenesisKV{
+		NewGenesisKV("app_state.interchainquery.host_port", ICQHostPort),
+		NewGenesisKV("app_state.interchainquery.params", icqParams{HostEnabled: true, AllowQueries: allowQueries}),
+	}
+}
+
+// ICQChannelOpts returns the options of a channel from the ICQ controller port on a chain, e.g. the port of a
+// contract, to the async-icq host of the counterparty chain. See ibc.Relayer.CreateChannel.
+func ICQChannelOpts(controllerPortID string) ibc.CreateChannelOptions {
+	return ibc.CreateChannelOptions{
+		SourcePortName: controllerPortID,
+		DestPortName:   ICQHostPort,
+		Order:          ibc.Unordered,
+		Version:        ICQVersion,
+	}
+}
+
+type icqParams struct {
+	HostEnabled  bool     `json:"host_enabled"`
+	AllowQueries []string `json:"allow_queries"`
+}
+
+// ICQHostUpdateParams submits a governance proposal updating the async-icq host params, and votes yes on it with
+// all validators. Use ICQHostGenesis to configure the host at genesis instead.
+func (c *CosmosChain) ICQHostUpdateParams(ctx context.Context, keyName string, hostEnabled bool, allowQueries []string, deposit string) (TxProposal, error) {
+	return c.submitAndVoteProposal(ctx, keyName, func(authority string) ([]json.RawMessage, error) {
+		// The async-icq types are not registered with the codec, so the message is built as JSON.
+		msg, err := json.Marshal(struct {
+			Type      string    `json:"@type"`
+			Authority string    `json:"authority"`
+			Params    icqParams `json:"params"`
+		}{"/icq.v1.MsgUpdateParams", authority, icqParams{HostEnabled: hostEnabled, AllowQueries: allowQueries}})
+		if err != nil {
+			return nil, err
+		}
+		return []json.RawMessage{msg}, nil
+	},
+		"Update interchain query host params",
+		fmt.Sprintf("Set host enabled to %t and allow queries %v", hostEnabled, allowQueries),
+		deposit,
+	)
+}
+
+// NewICQRequest returns a request for the gRPC query at path, e.g. "/cosmos.bank.v1beta1.Query/AllBalances",
+// with the proto encoded req.
+func NewICQRequest(path string, req proto.Message) (abcitypes.RequestQuery, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return abcitypes.RequestQuery{}, fmt.Errorf("failed to marshal %s request: %w", path, err)
+	}
+	return abcitypes.RequestQuery{Path: path, Data: data}, nil
+}
+
+// ICQPacketData returns the JSON packet data of an interchain query of the requests.
+func ICQPacketData(memo string, requests ...abcitypes.RequestQuery) ([]byte, error) {
+	var query []byte
+	for _, req := range requests {
+		bz, err := req.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		// CosmosQuery: repeated tendermint.abci.RequestQuery requests = 1
+		query = protowire.AppendTag(query, 1, protowire.BytesType)
+		query = protowire.AppendBytes(query, bz)
+	}
+	return json.Marshal(struct {
+		Data []byte `json:"data"`
+		Memo string `json:"memo,omitempty"`
+	}{query, memo})
+}
+
+// icqContractRequest is a request in the messages of ICQ controller contracts, which require height and prove.
+type icqContractRequest struct {
+	Data   []byte `json:"data"`
+	Path   string `json:"path"`
+	Height int64  `json:"height"`
+	Prove  bool   `json:"prove"`
+}
+
+// ICQSendQuery sends an interchain query of the requests over the channel through an ICQ controller contract,
+// e.g. the async-icq reference contract, executing its "query" message. The returned transaction includes the
+// packet, whose acknowledgement can be awaited with testutil.PollForAck and decoded with ICQAckResponses.
+func (c *CosmosChain) ICQSendQuery(ctx context.Context, keyName, contractAddress, channelID string, timeout uint64, requests ...abcitypes.RequestQuery) (ibc.Tx, error) {
+	contractRequests := make([]icqContractRequest, len(requests))
+	for i, req := range requests {
+		contractRequests[i] = icqContractRequest{Data: req.Data, Path: req.Path, Height: req.Height, Prove: req.Prove}
+	}
+
+	msg, err := json.Marshal(map[string]any{
+		"query": map[string]any{
+			"channel":  channelID,
+			"requests": contractRequests,
+			"timeout":  timeout,
+		},
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	res, err := c.ExecuteContract(ctx, keyName, contractAddress, string(msg))
+	if err != nil {
+		return ibc.Tx{}, fmt.Errorf("send interchain query: %w", err)
+	}
+	return c.sendPacketTx(res.TxHash)
+}
+
+// ICQAckResponses returns the responses to the requests of an interchain query, decoded from the acknowledgement
+// of its packet. Returns an error for error acknowledgements.
+func ICQAckResponses(ack ibc.PacketAcknowledgement) ([]abcitypes.ResponseQuery, error) {
+	var res chantypes.Acknowledgement
+	if err := chantypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement, &res); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal acknowledgement: %w", err)
+	}
+	if !res.Success() {
+		return nil, fmt.Errorf("error acknowledgement: %s", res.GetError())
+	}
+
+	var packetAck struct {
+		Data []byte `json:"data"`
+	}
+	if err := json.Unmarshal(res.GetResult(), &packetAck); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal interchain query acknowledgement: %w", err)
+	}
+
+	// CosmosResponse: repeated tendermint.abci.ResponseQuery responses = 1
+	var responses []abcitypes.ResponseQuery
+	bz := packetAck.Data
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		var resp abcitypes.ResponseQuery
+		if err := resp.Unmarshal(value); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal query response: %w", err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
@@ -0,0 +1,62 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestICQPacketData(t *testing.T) {
+	req, err := NewICQRequest("/cosmos.bank.v1beta1.Query/AllBalances", &banktypes.QueryAllBalancesRequest{Address: "cosmos1"})
+	require.NoError(t, err)
+
+	bz, err := ICQPacketData("", req, req)
+	require.NoError(t, err)
+
+	var packetData struct {
+		Data []byte `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &packetData))
+
+	reqBz, err := req.Marshal()
+	require.NoError(t, err)
+	var want []byte
+	for i := 0; i < 2; i++ {
+		want = protowire.AppendTag(want, 1, protowire.BytesType)
+		want = protowire.AppendBytes(want, reqBz)
+	}
+	require.Equal(t, want, packetData.Data)
+}
+
+func TestICQAckResponses(t *testing.T) {
+	responses := []abcitypes.ResponseQuery{{Value: []byte("a")}, {Code: 1, Log: "failed"}}
+
+	var data []byte
+	for _, res := range responses {
+		bz, err := res.Marshal()
+		require.NoError(t, err)
+		data = protowire.AppendTag(data, 1, protowire.BytesType)
+		data = protowire.AppendBytes(data, bz)
+	}
+	result, err := json.Marshal(map[string][]byte{"data": data})
+	require.NoError(t, err)
+
+	ack := ibc.PacketAcknowledgement{Acknowledgement: chantypes.NewResultAcknowledgement(result).Acknowledgement()}
+	got, err := ICQAckResponses(ack)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, []byte("a"), got[0].Value)
+	require.Equal(t, uint32(1), got[1].Code)
+	require.Equal(t, "failed", got[1].Log)
+
+	ack = ibc.PacketAcknowledgement{Acknowledgement: chantypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()}
+	_, err = ICQAckResponses(ack)
+	require.Error(t, err)
+}
@@ -0,0 +1,177 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	nftv1beta1 "cosmossdk.io/api/cosmos/nft/v1beta1"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// The nft helpers require an ICS-721 chain with the irismod nft module, e.g. irishub v2, whose CLI issues classes &
+// mints tokens, and which serves the cosmos.nft.v1beta1 gRPC queries of the x/nft store backing it.
+
+// NftIssueClass issues a class of non-fungible tokens, which anyone can mint.
+// x/nft has no messages to issue classes or mint tokens, so this uses the irismod nft module of ICS-721 chains.
+func (tn *ChainNode) NftIssueClass(ctx context.Context, keyName, classID, name string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"nft", "issue", classID,
+		"--name", name,
+		"--mint-restricted=false",
+		"--update-restricted=false",
+	)
+	return err
+}
+
+// NftMint mints the token of the class to recipient, using the irismod nft module like NftIssueClass.
+func (tn *ChainNode) NftMint(ctx context.Context, keyName, classID, nftID, uri, recipient string) error {
+	_, err := tn.ExecTx(ctx, keyName,
+		"nft", "mint", classID, nftID,
+		"--uri", uri,
+		"--recipient", recipient,
+	)
+	return err
+}
+
+// NftTransferSend sends the tokens of the class to receiver over the ICS-721 channel. Returns the transaction hash.
+func (tn *ChainNode) NftTransferSend(ctx context.Context, keyName, channelID, receiver, classID string, nftIDs ...string) (string, error) {
+	return tn.ExecTx(ctx, keyName,
+		"nft-transfer", "transfer", ibc.NFTTransferPortID, channelID, receiver, classID, strings.Join(nftIDs, ","),
+	)
+}
+
+// SendNFTTransfer sends the tokens of the class to receiver over the ICS-721 channel of the nft-transfer module.
+// The class of the tokens on the counterparty is ibc.NFTClassID of their ibc.NFTClassTrace.
+func (c *CosmosChain) SendNFTTransfer(ctx context.Context, channelID, keyName, receiver, classID string, nftIDs ...string) (ibc.Tx, error) {
+	txHash, err := c.getFullNode().NftTransferSend(ctx, keyName, channelID, receiver, classID, nftIDs...)
+	if err != nil {
+		return ibc.Tx{}, fmt.Errorf("send nft transfer: %w", err)
+	}
+	return c.sendPacketTx(txHash)
+}
+
+// NftQueryOwner returns the owner of the token.
+func (c *CosmosChain) NftQueryOwner(ctx context.Context, classID, nftID string) (string, error) {
+	res, err := nftv1beta1.NewQueryClient(c.GetNode().GrpcConn).Owner(ctx, &nftv1beta1.QueryOwnerRequest{
+		ClassId: classID,
+		Id:      nftID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Owner, nil
+}
+
+// NftQueryClass returns the class.
+func (c *CosmosChain) NftQueryClass(ctx context.Context, classID string) (*nftv1beta1.Class, error) {
+	res, err := nftv1beta1.NewQueryClient(c.GetNode().GrpcConn).Class(ctx, &nftv1beta1.QueryClassRequest{
+		ClassId: classID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Class, nil
+}
+
+// NftQueryClasses returns all classes.
+func (c *CosmosChain) NftQueryClasses(ctx context.Context) ([]*nftv1beta1.Class, error) {
+	res, err := nftv1beta1.NewQueryClient(c.GetNode().GrpcConn).Classes(ctx, &nftv1beta1.QueryClassesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Classes, nil
+}
+
+// Cw721Mint mints the token of the cw721 collection contract to owner. keyName must be the minter of the collection.
+func (c *CosmosChain) Cw721Mint(ctx context.Context, keyName, contractAddress, tokenID, owner, tokenURI string) error {
+	msg, err := json.Marshal(map[string]any{
+		"mint": map[string]any{
+			"token_id":  tokenID,
+			"owner":     owner,
+			"token_uri": tokenURI,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.ExecuteContract(ctx, keyName, contractAddress, string(msg))
+	return err
+}
+
+// Cw721QueryOwner returns the owner of the token of the cw721 collection contract.
+func (c *CosmosChain) Cw721QueryOwner(ctx context.Context, contractAddress, tokenID string) (string, error) {
+	var res struct {
+		Data struct {
+			Owner string `json:"owner"`
+		} `json:"data"`
+	}
+	query := map[string]any{"owner_of": map[string]any{"token_id": tokenID}}
+	if err := c.QueryContract(ctx, contractAddress, query, &res); err != nil {
+		return "", err
+	}
+	return res.Data.Owner, nil
+}
+
+// Cw721QueryTokens returns the IDs of the tokens of the cw721 collection contract owned by owner.
+func (c *CosmosChain) Cw721QueryTokens(ctx context.Context, contractAddress, owner string) ([]string, error) {
+	var res struct {
+		Data struct {
+			Tokens []string `json:"tokens"`
+		} `json:"data"`
+	}
+	query := map[string]any{"tokens": map[string]any{"owner": owner}}
+	if err := c.QueryContract(ctx, contractAddress, query, &res); err != nil {
+		return nil, err
+	}
+	return res.Data.Tokens, nil
+}
+
+// SendCw721Transfer sends the token of the cw721 collection contract to receiver over the ICS-721 channel of the
+// cw-ics721 contract, timing out after timeout.
+func (c *CosmosChain) SendCw721Transfer(ctx context.Context, keyName, cw721Address, ics721Address, channelID, tokenID, receiver string, timeout time.Duration) (ibc.Tx, error) {
+	outgoing, err := json.Marshal(map[string]any{
+		"receiver":   receiver,
+		"channel_id": channelID,
+		"timeout": map[string]any{
+			"timestamp": strconv.FormatInt(time.Now().Add(timeout).UnixNano(), 10),
+		},
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	msg, err := json.Marshal(map[string]any{
+		"send_nft": map[string]any{
+			"contract": ics721Address,
+			"token_id": tokenID,
+			"msg":      base64.StdEncoding.EncodeToString(outgoing),
+		},
+	})
+	if err != nil {
+		return ibc.Tx{}, err
+	}
+
+	res, err := c.ExecuteContract(ctx, keyName, cw721Address, string(msg))
+	if err != nil {
+		return ibc.Tx{}, fmt.Errorf("send cw721 transfer: %w", err)
+	}
+	return c.sendPacketTx(res.TxHash)
+}
+
+// Ics721QueryNftContract returns the address of the cw721 collection contract of the class on the cw-ics721
+// contract, e.g. of an ibc.NFTClassTrace received over IBC.
+func (c *CosmosChain) Ics721QueryNftContract(ctx context.Context, ics721Address, classID string) (string, error) {
+	var res struct {
+		Data string `json:"data"`
+	}
+	query := map[string]any{"nft_contract": map[string]any{"class_id": classID}}
+	if err := c.QueryContract(ctx, ics721Address, query, &res); err != nil {
+		return "", err
+	}
+	return res.Data, nil
+}
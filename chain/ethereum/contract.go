@@ -0,0 +1,240 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ForgeArtifact is a contract compiled by `forge build`, i.e. out/<File>.sol/<Contract>.json.
+type ForgeArtifact struct {
+	ABI      abi.ABI
+	Bytecode []byte
+}
+
+// ReadForgeArtifact reads the ABI and creation bytecode of a contract compiled by `forge build`.
+func ReadForgeArtifact(path string) (*ForgeArtifact, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	if err := json.Unmarshal(bz, &artifact); err != nil {
+		return nil, fmt.Errorf("unmarshal forge artifact %s: %w", path, err)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(artifact.ABI)))
+	if err != nil {
+		return nil, fmt.Errorf("parse abi of %s: %w", path, err)
+	}
+	bytecode, err := hexutil.Decode(artifact.Bytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("decode bytecode of %s: %w", path, err)
+	}
+	return &ForgeArtifact{ABI: contractABI, Bytecode: bytecode}, nil
+}
+
+// ethClient returns the client of the JSON-RPC of the chain from the host, dialed on first use.
+func (c *EthereumChain) ethClient(ctx context.Context) (*ethclient.Client, error) {
+	c.rpcMu.Lock()
+	defer c.rpcMu.Unlock()
+	if c.rpc != nil {
+		return c.rpc, nil
+	}
+	client, err := ethclient.DialContext(ctx, c.GetHostRPCAddress())
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", c.GetHostRPCAddress(), err)
+	}
+	c.rpc = client
+	return client, nil
+}
+
+// DeployContract deploys a contract compiled by `forge build` with the arguments of its constructor,
+// and waits for the transaction to be included. Returns the address of the contract.
+func (c *EthereumChain) DeployContract(ctx context.Context, keyName string, artifact *ForgeArtifact, args ...any) (common.Address, *types.Receipt, error) {
+	input, err := artifact.ABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("pack constructor arguments: %w", err)
+	}
+	data := append(append([]byte{}, artifact.Bytecode...), input...)
+
+	receipt, err := c.sendAndWait(ctx, keyName, Tx{Data: data})
+	if err != nil {
+		return common.Address{}, receipt, fmt.Errorf("deploy contract: %w", err)
+	}
+	if receipt.ContractAddress == (common.Address{}) {
+		return common.Address{}, receipt, errors.New("deploy contract: receipt has no contract address")
+	}
+	return receipt.ContractAddress, receipt, nil
+}
+
+// TransactContract calls the method of the contract in a transaction and waits for it to be included.
+// Returns an error with the receipt if the transaction reverted.
+func (c *EthereumChain) TransactContract(ctx context.Context, keyName string, contract common.Address, contractABI abi.ABI, method string, args ...any) (*types.Receipt, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack arguments of %s: %w", method, err)
+	}
+	receipt, err := c.sendAndWait(ctx, keyName, Tx{To: &contract, Data: data})
+	if err != nil {
+		return receipt, fmt.Errorf("transact %s: %w", method, err)
+	}
+	return receipt, nil
+}
+
+func (c *EthereumChain) sendAndWait(ctx context.Context, keyName string, tx Tx) (*types.Receipt, error) {
+	txHash, err := c.SendTx(ctx, keyName, tx)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.WaitForReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return receipt, fmt.Errorf("transaction %s reverted", txHash)
+	}
+	return receipt, nil
+}
+
+// CallContract calls the method of the contract without a transaction, at the latest block,
+// and returns its unpacked outputs.
+func (c *EthereumChain) CallContract(ctx context.Context, contract common.Address, contractABI abi.ABI, method string, args ...any) ([]any, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack arguments of %s: %w", method, err)
+	}
+	client, err := c.ethClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", method, err)
+	}
+	return contractABI.Unpack(method, output)
+}
+
+// WaitForReceipt polls the receipt of the transaction until it is included in a block, or the context is done.
+func (c *EthereumChain) WaitForReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	client, err := c.ethClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(c.blockTime / 4)
+	defer ticker.Stop()
+	for {
+		receipt, err := client.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("receipt of %s: %w", txHash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for receipt of %s: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// ContractEvents returns the event logs of the contract with the event between the blocks, inclusive.
+// The event is its name or signature in the ABI, e.g. Transfer or Transfer(address,address,uint256).
+// A nil toBlock is the latest block. Each event is decoded into a map of its arguments by name.
+func (c *EthereumChain) ContractEvents(ctx context.Context, contract common.Address, contractABI abi.ABI, event string, fromBlock uint64, toBlock *uint64) ([]map[string]any, error) {
+	ev, err := abiEvent(contractABI, event)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.ethClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		Addresses: []common.Address{contract},
+		Topics:    [][]common.Hash{{ev.ID}},
+	}
+	if toBlock != nil {
+		query.ToBlock = new(big.Int).SetUint64(*toBlock)
+	}
+
+	logs, err := client.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("logs of %s: %w", event, err)
+	}
+
+	events := make([]map[string]any, 0, len(logs))
+	for _, log := range logs {
+		decoded, err := UnpackEvent(contractABI, event, log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, decoded)
+	}
+	return events, nil
+}
+
+// UnpackEvent decodes the indexed and non-indexed arguments of the event log into a map by name,
+// e.g. for the logs of a receipt.
+func UnpackEvent(contractABI abi.ABI, event string, log types.Log) (map[string]any, error) {
+	ev, err := abiEvent(contractABI, event)
+	if err != nil {
+		return nil, err
+	}
+	if len(log.Topics) == 0 || log.Topics[0] != ev.ID {
+		return nil, fmt.Errorf("log is not a %s event", event)
+	}
+
+	decoded := make(map[string]any)
+	if err := contractABI.UnpackIntoMap(decoded, ev.Name, log.Data); err != nil {
+		return nil, fmt.Errorf("unpack %s: %w", event, err)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range ev.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(decoded, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("parse topics of %s: %w", event, err)
+	}
+	return decoded, nil
+}
+
+// abiEvent returns the event of the ABI by name or by signature.
+func abiEvent(contractABI abi.ABI, event string) (abi.Event, error) {
+	if ev, ok := contractABI.Events[event]; ok {
+		return ev, nil
+	}
+	for _, ev := range contractABI.Events {
+		if ev.Sig == event {
+			return ev, nil
+		}
+	}
+	return abi.Event{}, fmt.Errorf("no event %s in abi", event)
+}
@@ -0,0 +1,58 @@
+package ethereum
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+const erc20ABI = `[
+	{"type":"constructor","inputs":[{"name":"initialSupply","type":"uint256"}],"stateMutability":"nonpayable"},
+	{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false}
+]`
+
+func TestReadForgeArtifact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Token.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"abi":`+erc20ABI+`,"bytecode":{"object":"0x6080604052","sourceMap":"","linkReferences":{}},"deployedBytecode":{"object":"0x6080"}}`), 0o644))
+
+	artifact, err := ReadForgeArtifact(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, artifact.Bytecode)
+	require.Contains(t, artifact.ABI.Methods, "balanceOf")
+	require.Len(t, artifact.ABI.Constructor.Inputs, 1)
+}
+
+func TestUnpackEvent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Token.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"abi":`+erc20ABI+`,"bytecode":{"object":"0x"}}`), 0o644))
+	artifact, err := ReadForgeArtifact(path)
+	require.NoError(t, err)
+
+	from := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	log := types.Log{
+		Topics: []common.Hash{
+			common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000003e8"),
+	}
+
+	for _, event := range []string{"Transfer", "Transfer(address,address,uint256)"} {
+		decoded, err := UnpackEvent(artifact.ABI, event, log)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"from": from, "to": to, "value": big.NewInt(1000)}, decoded)
+	}
+
+	log.Topics[0] = common.Hash{}
+	_, err = UnpackEvent(artifact.ABI, "Transfer", log)
+	require.EqualError(t, err, "log is not a Transfer event")
+}
@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	dockertypes "github.com/docker/docker/api/types"
@@ -17,6 +20,7 @@ import (
 	"github.com/docker/docker/api/types/volume"
 	dockerclient "github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/internal/dockerutil"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
@@ -26,10 +30,19 @@ import (
 var _ ibc.Chain = &EthereumChain{}
 
 const (
-	blockTime = 2 // seconds
-	rpcPort   = "8545/tcp"
-	GWEI      = 1_000_000_000
-	ETHER     = 1_000_000_000 * GWEI
+	defaultBlockTime = 2 * time.Second
+	rpcPort          = "8545/tcp"
+	GWEI             = 1_000_000_000
+	ETHER            = 1_000_000_000 * GWEI
+)
+
+// Keys of ChainConfig.ConfigFileOverrides, for all backends.
+const (
+	// BlockTimeOverride is the number of seconds between blocks, 2 by default.
+	BlockTimeOverride = "block_time"
+	// FinalityDepthOverride is the number of blocks on top of a block before it is final, 0 by default.
+	// See FinalizedHeight.
+	FinalityDepthOverride = "finality_depth"
 )
 
 var natPorts = nat.PortMap{
@@ -53,6 +66,20 @@ type EthereumChain struct {
 	genesisWallets GenesisWallets
 
 	keystoreMap map[string]string
+
+	// Nodes of geth and reth chains. Anvil chains run a single container.
+	numValidators int
+	numFullNodes  int
+	Validators    EthereumNodes
+	FullNodes     EthereumNodes
+
+	blockTime     time.Duration
+	finalityDepth int64
+
+	hostWSPort string
+
+	rpcMu sync.Mutex
+	rpc   *ethclient.Client
 }
 
 func DefaultEthereumAnvilChainConfig(
@@ -81,12 +108,105 @@ func DefaultEthereumAnvilChainConfig(
 }
 
 func NewEthereumChain(testName string, chainConfig ibc.ChainConfig, log *zap.Logger) *EthereumChain {
+	return NewEthereumChainWithNodes(testName, chainConfig, 1, 0, log)
+}
+
+// NewEthereumChainWithNodes returns a chain of the backend of chainConfig.Bin: anvil, geth or reth.
+// The node counts only apply to geth networks, anvil and reth chains run a single node.
+func NewEthereumChainWithNodes(testName string, chainConfig ibc.ChainConfig, numValidators int, numFullNodes int, log *zap.Logger) *EthereumChain {
 	return &EthereumChain{
 		testName:       testName,
 		cfg:            chainConfig,
 		log:            log,
 		genesisWallets: NewGenesisWallet(),
 		keystoreMap:    make(map[string]string),
+		numValidators:  numValidators,
+		numFullNodes:   numFullNodes,
+		blockTime:      defaultBlockTime,
+	}
+}
+
+// backend returns the client running the chain, from the binary of the chain config.
+func (c *EthereumChain) backend() string {
+	switch bin := path.Base(c.cfg.Bin); bin {
+	case "geth", "reth":
+		return bin
+	default:
+		return "anvil"
+	}
+}
+
+// Nodes returns the validators and full nodes of geth and reth chains.
+func (c *EthereumChain) Nodes() EthereumNodes {
+	return append(append(EthereumNodes{}, c.Validators...), c.FullNodes...)
+}
+
+// rpcNode returns the node serving the RPC of the chain, a full node if any.
+func (c *EthereumChain) rpcNode() *EthereumNode {
+	if len(c.FullNodes) > 0 {
+		return c.FullNodes[0]
+	}
+	if len(c.Validators) > 0 {
+		return c.Validators[0]
+	}
+	return nil
+}
+
+// foundryImage returns the image running cast, the node image of anvil chains or the second image otherwise.
+func (c *EthereumChain) foundryImage() ibc.DockerImage {
+	if len(c.cfg.Images) > 1 {
+		return c.cfg.Images[1]
+	}
+	return c.cfg.Images[0]
+}
+
+// parseOverrides reads the block time and finality depth of the config file overrides.
+func (c *EthereumChain) parseOverrides() error {
+	blockTime, err := intOverride(c.cfg.ConfigFileOverrides, BlockTimeOverride, int64(defaultBlockTime/time.Second))
+	if err != nil {
+		return err
+	}
+	if blockTime < 1 {
+		return fmt.Errorf("%s must be at least 1 second, got %d", BlockTimeOverride, blockTime)
+	}
+	c.blockTime = time.Duration(blockTime) * time.Second
+
+	c.finalityDepth, err = intOverride(c.cfg.ConfigFileOverrides, FinalityDepthOverride, 0)
+	if err != nil {
+		return err
+	}
+	if c.finalityDepth < 0 {
+		return fmt.Errorf("%s must not be negative, got %d", FinalityDepthOverride, c.finalityDepth)
+	}
+	return nil
+}
+
+func intOverride(overrides map[string]any, key string, defaultValue int64) (int64, error) {
+	v, ok := overrides[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		// Overrides decoded from JSON or YAML are float64.
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("%s must be an integer, got %v", key, v)
+		}
+		return int64(v), nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", key, err)
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("%s must be an integer, got %T", key, v)
 	}
 }
 
@@ -95,9 +215,11 @@ func (c *EthereumChain) Config() ibc.ChainConfig {
 }
 
 func (c *EthereumChain) Initialize(ctx context.Context, testName string, cli *dockerclient.Client, networkID string) error {
-	chainCfg := c.Config()
+	if err := c.parseOverrides(); err != nil {
+		return err
+	}
 	c.pullImages(ctx, cli)
-	image := chainCfg.Images[0]
+	image := c.foundryImage()
 
 	c.containerLifecycle = dockerutil.NewContainerLifecycle(c.log, cli, c.Name())
 
@@ -128,11 +250,17 @@ func (c *EthereumChain) Initialize(ctx context.Context, testName string, cli *do
 		return fmt.Errorf("set volume owner: %w", err)
 	}
 
+	switch c.backend() {
+	case "geth":
+		return c.initializeGeth(ctx)
+	case "reth":
+		return c.initializeReth(ctx)
+	}
 	return nil
 }
 
 func (c *EthereumChain) Name() string {
-	return fmt.Sprintf("anvil-%s-%s", c.cfg.ChainID, dockerutil.SanitizeContainerName(c.testName))
+	return fmt.Sprintf("%s-%s-%s", c.backend(), c.cfg.ChainID, dockerutil.SanitizeContainerName(c.testName))
 }
 
 func (c *EthereumChain) HomeDir() string {
@@ -171,8 +299,6 @@ func (c *EthereumChain) Start(testName string, ctx context.Context, additionalGe
 	// TODO:
 	//   * add support for different denom configuration, ether or wei, this will affect GetBalance, etc
 	//   * add support for modifying genesis amount config, default is 10 ether
-	//   * add support for ConfigFileOverrides
-	//		* block time
 	//   * add support for custom chain id, must be an int?
 	//   * add support for custom gas-price
 	// Maybe add code-size-limit configuration for larger contracts
@@ -180,9 +306,16 @@ func (c *EthereumChain) Start(testName string, ctx context.Context, additionalGe
 	// IBC support, add when necessary
 	//   * add additionalGenesisWallet support for relayer wallet, either add genesis accounts or tx after chain starts
 
+	switch c.backend() {
+	case "geth":
+		return c.startGeth(ctx)
+	case "reth":
+		return c.startReth(ctx)
+	}
+
 	cmd := []string{c.cfg.Bin,
 		"--host", "0.0.0.0", // Anyone can call
-		"--block-time", "2", // 2 second block times
+		"--block-time", strconv.FormatInt(int64(c.blockTime/time.Second), 10),
 		"--accounts", "10", // We current only use the first account for the faucet, but tests may expect the default
 		"--balance", "10000000", // Genesis accounts loaded with 10mil ether, change as needed
 	}
@@ -239,17 +372,23 @@ func (c *EthereumChain) Start(testName string, ctx context.Context, additionalGe
 	}
 
 	c.hostRPCPort = hostPorts[0]
+	c.hostWSPort = c.hostRPCPort // anvil serves websockets on the RPC port
 	fmt.Println("Host RPC port: ", c.hostRPCPort)
 
 	return testutil.WaitForBlocks(ctx, 2, c)
 }
 
+// HostName returns the docker hostname of the anvil container, or of the RPC node of geth and reth chains.
 func (c *EthereumChain) HostName() string {
+	if n := c.rpcNode(); n != nil {
+		return n.HostName()
+	}
 	return dockerutil.CondenseHostName(c.Name())
 }
 
 func (c *EthereumChain) Exec(ctx context.Context, cmd []string, env []string) (stdout, stderr []byte, err error) {
-	job := dockerutil.NewImage(c.logger(), c.DockerClient, c.NetworkID, c.testName, c.cfg.Images[0].Repository, c.cfg.Images[0].Version)
+	image := c.foundryImage()
+	job := dockerutil.NewImage(c.logger(), c.DockerClient, c.NetworkID, c.testName, image.Repository, image.Version)
 	opts := dockerutil.ContainerOptions{
 		Env:   env,
 		Binds: c.Bind(),
@@ -270,6 +409,9 @@ func (c *EthereumChain) GetRPCAddress() string {
 }
 
 func (c *EthereumChain) GetWSAddress() string {
+	if c.backend() != "anvil" {
+		return fmt.Sprintf("ws://%s:%s", c.HostName(), strings.Split(wsPort, "/")[0])
+	}
 	return fmt.Sprintf("ws://%s:8545", c.HostName())
 }
 
@@ -278,7 +420,7 @@ func (c *EthereumChain) GetHostRPCAddress() string {
 }
 
 func (c *EthereumChain) GetHostWSAddress() string {
-	return "ws://" + c.hostRPCPort
+	return "ws://" + c.hostWSPort
 }
 
 type NewWalletOutput struct {
@@ -330,7 +472,7 @@ func (c *EthereumChain) SendFunds(ctx context.Context, keyName string, amount ib
 	cmd := []string{"cast", "send", amount.Address, "--value", amount.Amount.String()}
 	if keyName == "faucet" {
 		cmd = append(cmd,
-			"--private-key", "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+			"--private-key", faucetPrivateKey,
 			"--rpc-url", c.GetRPCAddress(),
 		)
 
@@ -354,6 +496,46 @@ func (c *EthereumChain) Height(ctx context.Context) (int64, error) {
 	return strconv.ParseInt(strings.TrimSpace(string(stdout)), 10, 64)
 }
 
+// BlockTime returns the time between blocks, set by the BlockTimeOverride config file override.
+func (c *EthereumChain) BlockTime() time.Duration {
+	return c.blockTime
+}
+
+// FinalizedHeight returns the height of the last final block, i.e. the latest height minus the finality depth
+// set by the FinalityDepthOverride config file override. None of the backends finalize blocks, so relayers
+// waiting for finality should wait for this height.
+func (c *EthereumChain) FinalizedHeight(ctx context.Context) (int64, error) {
+	height, err := c.Height(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if height < c.finalityDepth {
+		return 0, nil
+	}
+	return height - c.finalityDepth, nil
+}
+
+// WaitForFinality waits until the block at the height is final.
+func (c *EthereumChain) WaitForFinality(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(c.blockTime)
+	defer ticker.Stop()
+	for {
+		finalized, err := c.FinalizedHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if finalized >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for finality of height %d: %w", height, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 func (c *EthereumChain) GetBalance(ctx context.Context, address string, denom string) (sdkmath.Int, error) {
 	cmd := []string{"cast", "balance", "--rpc-url", c.GetRPCAddress(), address}
 	stdout, _, err := c.Exec(ctx, cmd, nil)
@@ -0,0 +1,42 @@
+package ethereum
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseOverrides(t *testing.T) {
+	c := NewEthereumChain("test", DefaultEthereumAnvilChainConfig("ethereum"), zap.NewNop())
+	require.NoError(t, c.parseOverrides())
+	require.Equal(t, 2*time.Second, c.BlockTime())
+	require.Equal(t, int64(0), c.finalityDepth)
+
+	cfg := DefaultEthereumGethChainConfig("ethereum")
+	cfg.ConfigFileOverrides = map[string]any{
+		BlockTimeOverride:     5,
+		FinalityDepthOverride: "12",
+	}
+	c = NewEthereumChainWithNodes("test", cfg, 3, 1, zap.NewNop())
+	require.NoError(t, c.parseOverrides())
+	require.Equal(t, 5*time.Second, c.BlockTime())
+	require.Equal(t, int64(12), c.finalityDepth)
+	require.Equal(t, "geth", c.backend())
+	require.Equal(t, "ghcr.io/foundry-rs/foundry", c.foundryImage().Repository)
+
+	cfg.ConfigFileOverrides = map[string]any{BlockTimeOverride: 0}
+	c = NewEthereumChainWithNodes("test", cfg, 3, 1, zap.NewNop())
+	require.EqualError(t, c.parseOverrides(), "block_time must be at least 1 second, got 0")
+
+	// Overrides decoded from JSON or YAML are float64.
+	cfg.ConfigFileOverrides = map[string]any{BlockTimeOverride: float64(3)}
+	c = NewEthereumChainWithNodes("test", cfg, 3, 1, zap.NewNop())
+	require.NoError(t, c.parseOverrides())
+	require.Equal(t, 3*time.Second, c.BlockTime())
+
+	cfg.ConfigFileOverrides = map[string]any{BlockTimeOverride: 1.5}
+	c = NewEthereumChainWithNodes("test", cfg, 3, 1, zap.NewNop())
+	require.EqualError(t, c.parseOverrides(), "block_time must be an integer, got 1.5")
+}
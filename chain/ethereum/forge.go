@@ -25,7 +25,7 @@ func (c *EthereumChain) AddKey(cmd []string, keyName string) []string {
 	// choose whether to use private-key or keystore
 	if keyName == "faucet" {
 		cmd = append(cmd,
-			"--private-key", "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+			"--private-key", faucetPrivateKey,
 		)
 
 	} else {
@@ -0,0 +1,76 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// devAccounts are the accounts of the mnemonic "test test test test test test test test test test test junk",
+// funded at genesis by anvil and reth in dev mode. The first one is the faucet.
+var devAccounts = []common.Address{
+	common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+	common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+	common.HexToAddress("0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"),
+	common.HexToAddress("0x90F79bf6EB2c4f870365E785982E1f101E93b906"),
+	common.HexToAddress("0x15d34AAf54267DB7D7c367839AAf71A00a2C6A65"),
+	common.HexToAddress("0x9965507D1a55bcC2695C58ba16FB37d819B0A4dc"),
+	common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9"),
+	common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955"),
+	common.HexToAddress("0x23618e81E3f5cdF7f54C3d65f7FBc0aBf5B21E8f"),
+	common.HexToAddress("0xa0Ee7A142d267C1f36714E4a8F75612F20a79720"),
+}
+
+// devAccountBalance is the genesis balance of the dev accounts, 10 million ether like anvil.
+var devAccountBalance = new(big.Int).Mul(big.NewInt(10_000_000), big.NewInt(ETHER))
+
+const (
+	cliqueVanity = 32
+	cliqueSeal   = 65
+)
+
+// cliqueGenesis returns the genesis of a proof of authority network of the signers, with all forks up to london
+// active at genesis, and blocks every period seconds.
+func cliqueGenesis(chainID *big.Int, period uint64, signers []common.Address, alloc map[common.Address]*big.Int) ([]byte, error) {
+	type account struct {
+		Balance *hexutil.Big `json:"balance"`
+	}
+	accounts := make(map[common.Address]account, len(alloc))
+	for addr, balance := range alloc {
+		accounts[addr] = account{Balance: (*hexutil.Big)(balance)}
+	}
+
+	// The extra data of the genesis is the vanity, the initial signers and an empty seal.
+	extraData := make([]byte, cliqueVanity, cliqueVanity+len(signers)*common.AddressLength+cliqueSeal)
+	for _, signer := range signers {
+		extraData = append(extraData, signer.Bytes()...)
+	}
+	extraData = append(extraData, make([]byte, cliqueSeal)...)
+
+	genesis := map[string]any{
+		"config": map[string]any{
+			"chainId":             chainID,
+			"homesteadBlock":      0,
+			"eip150Block":         0,
+			"eip155Block":         0,
+			"eip158Block":         0,
+			"byzantiumBlock":      0,
+			"constantinopleBlock": 0,
+			"petersburgBlock":     0,
+			"istanbulBlock":       0,
+			"berlinBlock":         0,
+			"londonBlock":         0,
+			"clique": map[string]any{
+				"period": period,
+				"epoch":  30000,
+			},
+		},
+		"difficulty": "0x1",
+		"gasLimit":   hexutil.Uint64(30_000_000),
+		"extraData":  hexutil.Bytes(extraData),
+		"alloc":      accounts,
+	}
+	return json.MarshalIndent(genesis, "", "  ")
+}
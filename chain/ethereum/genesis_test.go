@@ -0,0 +1,47 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCliqueGenesis(t *testing.T) {
+	signers := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+	bz, err := cliqueGenesis(big.NewInt(1337), 3, signers, map[common.Address]*big.Int{devAccounts[0]: devAccountBalance})
+	require.NoError(t, err)
+
+	var genesis struct {
+		Config struct {
+			ChainID     int64 `json:"chainId"`
+			LondonBlock int64 `json:"londonBlock"`
+			Clique      struct {
+				Period uint64 `json:"period"`
+				Epoch  uint64 `json:"epoch"`
+			} `json:"clique"`
+		} `json:"config"`
+		ExtraData hexutil.Bytes `json:"extraData"`
+		Alloc     map[common.Address]struct {
+			Balance *hexutil.Big `json:"balance"`
+		} `json:"alloc"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+	require.Equal(t, int64(1337), genesis.Config.ChainID)
+	require.Equal(t, uint64(3), genesis.Config.Clique.Period)
+
+	// The signers are between the 32 bytes of vanity and the 65 bytes of seal.
+	require.Len(t, genesis.ExtraData, 32+2*20+65)
+	require.Equal(t, make([]byte, 32), []byte(genesis.ExtraData[:32]))
+	require.Equal(t, signers[0].Bytes(), []byte(genesis.ExtraData[32:52]))
+	require.Equal(t, signers[1].Bytes(), []byte(genesis.ExtraData[52:72]))
+	require.Equal(t, make([]byte, 65), []byte(genesis.ExtraData[72:]))
+
+	require.Equal(t, "10000000000000000000000000", genesis.Alloc[devAccounts[0]].Balance.ToInt().String())
+}
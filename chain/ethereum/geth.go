@@ -0,0 +1,155 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultEthereumGethChainConfig is a proof of authority network of geth nodes, sealing blocks with clique.
+// The validators of the chain spec are the clique signers, and its full nodes only follow the chain.
+// The dev accounts of anvil are funded at genesis, so the faucet is the same as with anvil.
+// Cast runs in the foundry image, the second image.
+func DefaultEthereumGethChainConfig(
+	name string,
+) ibc.ChainConfig {
+	return ibc.ChainConfig{
+		Type:           "ethereum",
+		Name:           name,
+		ChainID:        "1337",
+		Bech32Prefix:   "n/a",
+		CoinType:       "60",
+		Denom:          "wei",
+		GasPrices:      "0",
+		GasAdjustment:  0,
+		TrustingPeriod: "0",
+		NoHostMount:    false,
+		Images: []ibc.DockerImage{
+			{
+				// The last release sealing pre-merge networks with clique.
+				Repository: "ethereum/client-go",
+				Version:    "v1.13.15",
+			},
+			{
+				Repository: "ghcr.io/foundry-rs/foundry",
+				Version:    "latest",
+				UidGid:     "1000:1000",
+			},
+		},
+		Bin: "geth",
+	}
+}
+
+// initializeGeth creates the validators and full nodes of the network.
+func (c *EthereumChain) initializeGeth(ctx context.Context) error {
+	if c.numValidators < 1 {
+		return fmt.Errorf("geth network needs at least one validator, got %d", c.numValidators)
+	}
+
+	c.Validators = make(EthereumNodes, c.numValidators)
+	c.FullNodes = make(EthereumNodes, c.numFullNodes)
+	var eg errgroup.Group
+	for i := range c.Validators {
+		i := i
+		eg.Go(func() (err error) {
+			c.Validators[i], err = c.newNode(ctx, i, true)
+			return err
+		})
+	}
+	for i := range c.FullNodes {
+		i := i
+		eg.Go(func() (err error) {
+			c.FullNodes[i], err = c.newNode(ctx, i, false)
+			return err
+		})
+	}
+	return eg.Wait()
+}
+
+// startGeth initializes the nodes with the clique genesis, starts them and peers every node with every other.
+func (c *EthereumChain) startGeth(ctx context.Context) error {
+	chainID, ok := new(big.Int).SetString(c.cfg.ChainID, 10)
+	if !ok {
+		return fmt.Errorf("chain id of geth network must be an integer, got %s", c.cfg.ChainID)
+	}
+
+	signers := make([]common.Address, len(c.Validators))
+	for i, val := range c.Validators {
+		signers[i] = val.SignerAddress()
+	}
+	alloc := make(map[common.Address]*big.Int, len(devAccounts))
+	for _, addr := range devAccounts {
+		alloc[addr] = devAccountBalance
+	}
+	genesis, err := cliqueGenesis(chainID, uint64(c.blockTime.Seconds()), signers, alloc)
+	if err != nil {
+		return fmt.Errorf("clique genesis: %w", err)
+	}
+	if c.cfg.ModifyGenesis != nil {
+		if genesis, err = c.cfg.ModifyGenesis(c.cfg, genesis); err != nil {
+			return fmt.Errorf("modify genesis: %w", err)
+		}
+	}
+
+	nodes := c.Nodes()
+	var eg errgroup.Group
+	for _, n := range nodes {
+		n := n
+		eg.Go(func() error {
+			if err := n.InitGeth(ctx, genesis); err != nil {
+				return err
+			}
+			if err := n.CreateNodeContainer(ctx, n.GethCommand()); err != nil {
+				return err
+			}
+			c.log.Info("Starting container", zap.String("container", n.Name()))
+			return n.StartContainer(ctx)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	if err := c.connectPeers(ctx); err != nil {
+		return err
+	}
+
+	rpcNode := c.rpcNode()
+	c.hostRPCPort, c.hostWSPort = rpcNode.hostRPCPort, rpcNode.hostWSPort
+	c.log.Info("Host RPC port", zap.String("port", c.hostRPCPort))
+
+	return testutil.WaitForBlocks(ctx, 2, c)
+}
+
+// connectPeers adds every node as a peer of the nodes after it, once all nodes are started and resolvable.
+func (c *EthereumChain) connectPeers(ctx context.Context) error {
+	nodes := c.Nodes()
+	for i, n := range nodes {
+		client, err := rpc.DialContext(ctx, n.GetHostRPCAddress())
+		if err != nil {
+			return fmt.Errorf("dial %s: %w", n.Name(), err)
+		}
+		for _, peer := range nodes[i+1:] {
+			// The RPC of the node may not be serving yet.
+			err := retry.Do(func() error {
+				var added bool
+				return client.CallContext(ctx, &added, "admin_addPeer", peer.Enode())
+			}, retry.Context(ctx), retry.Attempts(10), retry.Delay(time.Second), retry.LastErrorOnly(true))
+			if err != nil {
+				client.Close()
+				return fmt.Errorf("add peer %s to %s: %w", peer.Name(), n.Name(), err)
+			}
+		}
+		client.Close()
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/volume"
+	dockerclient "github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/dockerutil"
+	"go.uber.org/zap"
+)
+
+const (
+	wsPort  = "8546/tcp"
+	p2pPort = "30303/tcp"
+)
+
+// EthereumNode is a node of a geth or reth network. Anvil chains run a single container without nodes.
+type EthereumNode struct {
+	log      *zap.Logger
+	TestName string
+	Index    int
+	// Validator nodes seal blocks, i.e. are clique signers with geth.
+	Validator bool
+
+	Chain              *EthereumChain
+	NetworkID          string
+	DockerClient       *dockerclient.Client
+	VolumeName         string
+	Image              ibc.DockerImage
+	containerLifecycle *dockerutil.ContainerLifecycle
+
+	// NodeKey is the p2p key of the node.
+	NodeKey *ecdsa.PrivateKey
+	// SignerKey is the key sealing blocks of validators.
+	SignerKey *ecdsa.PrivateKey
+
+	hostRPCPort string
+	hostWSPort  string
+}
+
+type EthereumNodes []*EthereumNode
+
+// newNode creates the volume of the node and generates its keys.
+func (c *EthereumChain) newNode(ctx context.Context, index int, validator bool) (*EthereumNode, error) {
+	nodeKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	n := &EthereumNode{
+		log:          c.log,
+		TestName:     c.testName,
+		Index:        index,
+		Validator:    validator,
+		Chain:        c,
+		NetworkID:    c.NetworkID,
+		DockerClient: c.DockerClient,
+		Image:        c.cfg.Images[0],
+		NodeKey:      nodeKey,
+	}
+	if validator {
+		if n.SignerKey, err = crypto.GenerateKey(); err != nil {
+			return nil, err
+		}
+	}
+	n.containerLifecycle = dockerutil.NewContainerLifecycle(c.log, c.DockerClient, n.Name())
+
+	v, err := c.DockerClient.VolumeCreate(ctx, volume.CreateOptions{
+		Labels: map[string]string{
+			dockerutil.CleanupLabel: c.testName,
+
+			dockerutil.NodeOwnerLabel: n.Name(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating volume for chain node: %w", err)
+	}
+	n.VolumeName = v.Name
+
+	if err := dockerutil.SetVolumeOwner(ctx, dockerutil.VolumeOwnerOptions{
+		Log: c.log,
+
+		Client: c.DockerClient,
+
+		VolumeName: v.Name,
+		ImageRef:   n.Image.Ref(),
+		TestName:   c.testName,
+		UidGid:     n.Image.UidGid,
+	}); err != nil {
+		return nil, fmt.Errorf("set volume owner: %w", err)
+	}
+	return n, nil
+}
+
+// Name returns the name of the node container.
+func (n *EthereumNode) Name() string {
+	kind := "fn"
+	if n.Validator {
+		kind = "val"
+	}
+	return fmt.Sprintf("%s-%s-%s-%d-%s", n.Chain.cfg.Bin, n.Chain.cfg.ChainID, kind, n.Index, dockerutil.SanitizeContainerName(n.TestName))
+}
+
+// HostName returns the docker hostname of the node container.
+func (n *EthereumNode) HostName() string {
+	return dockerutil.CondenseHostName(n.Name())
+}
+
+// HomeDir is the data directory of the node, where its volume is mounted.
+func (n *EthereumNode) HomeDir() string {
+	return "/data"
+}
+
+func (n *EthereumNode) Bind() []string {
+	return []string{fmt.Sprintf("%s:%s", n.VolumeName, n.HomeDir())}
+}
+
+// SignerAddress returns the address sealing blocks of a validator.
+func (n *EthereumNode) SignerAddress() common.Address {
+	if n.SignerKey == nil {
+		return common.Address{}
+	}
+	return crypto.PubkeyToAddress(n.SignerKey.PublicKey)
+}
+
+// Enode returns the p2p url of the node in the docker network.
+func (n *EthereumNode) Enode() string {
+	pubKey := crypto.FromECDSAPub(&n.NodeKey.PublicKey)[1:]
+	return fmt.Sprintf("enode://%x@%s:%s", pubKey, n.HostName(), strings.Split(p2pPort, "/")[0])
+}
+
+func (n *EthereumNode) GetRPCAddress() string {
+	return fmt.Sprintf("http://%s:8545", n.HostName())
+}
+
+func (n *EthereumNode) GetHostRPCAddress() string {
+	return "http://" + n.hostRPCPort
+}
+
+func (n *EthereumNode) GetHostWSAddress() string {
+	return "ws://" + n.hostWSPort
+}
+
+func (n *EthereumNode) logger() *zap.Logger {
+	return n.log.With(
+		zap.String("chain_id", n.Chain.cfg.ChainID),
+		zap.String("test", n.TestName),
+		zap.String("node", n.Name()),
+	)
+}
+
+// Exec runs a one-off container of the node image with the node volume, and blocks until the container exits.
+func (n *EthereumNode) Exec(ctx context.Context, cmd []string, env []string) (stdout, stderr []byte, err error) {
+	job := dockerutil.NewImage(n.logger(), n.DockerClient, n.NetworkID, n.TestName, n.Image.Repository, n.Image.Version)
+	opts := dockerutil.ContainerOptions{
+		Env:   env,
+		Binds: n.Bind(),
+		User:  n.Image.UidGid,
+	}
+	res := job.Run(ctx, cmd, opts)
+	return res.Stdout, res.Stderr, res.Err
+}
+
+// WriteFile writes the file at the path relative to the home directory of the node.
+func (n *EthereumNode) WriteFile(ctx context.Context, content []byte, relPath string) error {
+	fw := dockerutil.NewFileWriter(n.logger(), n.DockerClient, n.TestName)
+	return fw.WriteFile(ctx, n.VolumeName, relPath, content)
+}
+
+// InitGeth writes the genesis to the data directory of the node, and imports the signer key of validators.
+func (n *EthereumNode) InitGeth(ctx context.Context, genesis []byte) error {
+	if err := n.WriteFile(ctx, genesis, "genesis.json"); err != nil {
+		return fmt.Errorf("write genesis: %w", err)
+	}
+	if _, _, err := n.Exec(ctx, []string{n.Chain.cfg.Bin, "init", "--datadir", n.HomeDir(), path.Join(n.HomeDir(), "genesis.json")}, nil); err != nil {
+		return fmt.Errorf("geth init: %w", err)
+	}
+	if !n.Validator {
+		return nil
+	}
+
+	if err := n.WriteFile(ctx, []byte(hex.EncodeToString(crypto.FromECDSA(n.SignerKey))), "signer.key"); err != nil {
+		return fmt.Errorf("write signer key: %w", err)
+	}
+	if err := n.WriteFile(ctx, []byte{}, "password.txt"); err != nil {
+		return fmt.Errorf("write signer password: %w", err)
+	}
+	cmd := []string{n.Chain.cfg.Bin, "account", "import",
+		"--datadir", n.HomeDir(),
+		"--password", path.Join(n.HomeDir(), "password.txt"),
+		path.Join(n.HomeDir(), "signer.key"),
+	}
+	if _, _, err := n.Exec(ctx, cmd, nil); err != nil {
+		return fmt.Errorf("import signer key: %w", err)
+	}
+	return nil
+}
+
+// GethCommand returns the command running geth, sealing blocks with the signer key of validators.
+func (n *EthereumNode) GethCommand() []string {
+	apis := "eth,net,web3,admin,txpool,debug"
+	cmd := []string{n.Chain.cfg.Bin,
+		"--datadir", n.HomeDir(),
+		"--networkid", n.Chain.cfg.ChainID,
+		"--syncmode", "full",
+		"--gcmode", "archive", // Relayers query the state at past heights
+		"--nodekeyhex", hex.EncodeToString(crypto.FromECDSA(n.NodeKey)),
+		"--port", strings.Split(p2pPort, "/")[0],
+		"--nodiscover", // Peers are added once all nodes are started
+		"--http", "--http.addr", "0.0.0.0", "--http.port", "8545", "--http.vhosts", "*", "--http.corsdomain", "*", "--http.api", apis,
+		"--ws", "--ws.addr", "0.0.0.0", "--ws.port", strings.Split(wsPort, "/")[0], "--ws.origins", "*", "--ws.api", apis,
+		"--rpc.allow-unprotected-txs",
+	}
+	if n.Validator {
+		signer := n.SignerAddress().Hex()
+		cmd = append(cmd,
+			"--mine",
+			"--miner.etherbase", signer,
+			"--unlock", signer,
+			"--password", path.Join(n.HomeDir(), "password.txt"),
+			"--allow-insecure-unlock",
+		)
+	}
+	return append(cmd, n.Chain.cfg.AdditionalStartArgs...)
+}
+
+// RethCommand returns the command running reth in dev mode, sealing a block every block time.
+func (n *EthereumNode) RethCommand() []string {
+	apis := "eth,net,web3,admin,txpool,debug,trace"
+	cmd := []string{n.Chain.cfg.Bin, "node",
+		"--dev",
+		"--dev.block-time", strconv.FormatInt(int64(n.Chain.blockTime.Seconds()), 10) + "s",
+		"--datadir", n.HomeDir(),
+		"--http", "--http.addr", "0.0.0.0", "--http.port", "8545", "--http.corsdomain", "*", "--http.api", apis,
+		"--ws", "--ws.addr", "0.0.0.0", "--ws.port", strings.Split(wsPort, "/")[0], "--ws.origins", "*", "--ws.api", apis,
+	}
+	return append(cmd, n.Chain.cfg.AdditionalStartArgs...)
+}
+
+// CreateNodeContainer creates the container of the node running the command.
+func (n *EthereumNode) CreateNodeContainer(ctx context.Context, cmd []string) error {
+	ports := nat.PortMap{
+		nat.Port(rpcPort): {},
+		nat.Port(wsPort):  {},
+		nat.Port(p2pPort): {},
+	}
+	return n.containerLifecycle.CreateContainer(ctx, n.TestName, n.NetworkID, n.Image, ports, n.Bind(), nil, n.HostName(), cmd, nil)
+}
+
+// StartContainer starts the container of the node, created by CreateNodeContainer.
+func (n *EthereumNode) StartContainer(ctx context.Context) error {
+	if err := n.containerLifecycle.StartContainer(ctx); err != nil {
+		return err
+	}
+
+	hostPorts, err := n.containerLifecycle.GetHostPorts(ctx, rpcPort, wsPort)
+	if err != nil {
+		return err
+	}
+	n.hostRPCPort, n.hostWSPort = hostPorts[0], hostPorts[1]
+	return nil
+}
+
+// StopContainer stops the container of the node.
+func (n *EthereumNode) StopContainer(ctx context.Context) error {
+	return n.containerLifecycle.StopContainer(ctx)
+}
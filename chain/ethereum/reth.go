@@ -0,0 +1,73 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"go.uber.org/zap"
+)
+
+// DefaultEthereumRethChainConfig is a single reth node in dev mode, sealing a block every block time.
+// Reth has no proof of authority consensus, so the node counts of the chain spec are ignored.
+// The dev accounts of anvil are funded at genesis, so the faucet is the same as with anvil.
+// Cast runs in the foundry image, the second image.
+func DefaultEthereumRethChainConfig(
+	name string,
+) ibc.ChainConfig {
+	return ibc.ChainConfig{
+		Type:           "ethereum",
+		Name:           name,
+		ChainID:        "1337", // reth dev chain-id
+		Bech32Prefix:   "n/a",
+		CoinType:       "60",
+		Denom:          "wei",
+		GasPrices:      "0",
+		GasAdjustment:  0,
+		TrustingPeriod: "0",
+		NoHostMount:    false,
+		Images: []ibc.DockerImage{
+			{
+				Repository: "ghcr.io/paradigmxyz/reth",
+				// The flags of RethCommand change between releases, upgrade with care.
+				Version: "v0.2.0-beta.6",
+			},
+			{
+				Repository: "ghcr.io/foundry-rs/foundry",
+				Version:    "latest",
+				UidGid:     "1000:1000",
+			},
+		},
+		Bin: "reth",
+	}
+}
+
+// initializeReth creates the dev node.
+func (c *EthereumChain) initializeReth(ctx context.Context) error {
+	val, err := c.newNode(ctx, 0, true)
+	if err != nil {
+		return err
+	}
+	c.Validators = EthereumNodes{val}
+	return nil
+}
+
+// startReth starts the dev node.
+func (c *EthereumChain) startReth(ctx context.Context) error {
+	n := c.Validators[0]
+	if err := n.CreateNodeContainer(ctx, n.RethCommand()); err != nil {
+		return err
+	}
+
+	c.log.Info("Starting container", zap.String("container", n.Name()))
+
+	if err := n.StartContainer(ctx); err != nil {
+		return fmt.Errorf("start reth: %w", err)
+	}
+
+	c.hostRPCPort, c.hostWSPort = n.hostRPCPort, n.hostWSPort
+	c.log.Info("Host RPC port", zap.String("port", c.hostRPCPort))
+
+	return testutil.WaitForBlocks(ctx, 2, c)
+}
@@ -0,0 +1,113 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// faucetPrivateKey is the private key of the first genesis account of anvil, the faucet.
+const faucetPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// Tx is a transaction to send, e.g. to call a contract. To is nil for contract creations.
+type Tx struct {
+	To    *common.Address
+	Value *big.Int
+	Data  []byte
+	// Gas is the gas limit, estimated when zero.
+	Gas uint64
+}
+
+// SendTx signs the transaction with the key and sends it to the host RPC, returning the transaction hash.
+// Use WaitForReceipt to wait for the transaction to be included in a block.
+func (c *EthereumChain) SendTx(ctx context.Context, keyName string, tx Tx) (common.Hash, error) {
+	key, err := c.privateKey(ctx, keyName)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("private key of %s: %w", keyName, err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	client, err := c.ethClient(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	value := tx.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("chain id: %w", err)
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("gas price: %w", err)
+	}
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("nonce of %s: %w", from, err)
+	}
+
+	gas := tx.Gas
+	if gas == 0 {
+		estimate, err := client.EstimateGas(ctx, ethereum.CallMsg{
+			From:  from,
+			To:    tx.To,
+			Value: value,
+			Data:  tx.Data,
+		})
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("estimate gas: %w", err)
+		}
+		// Leave a margin, as estimates of contract creations may fall short.
+		gas = estimate * 12 / 10
+	}
+
+	signed, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       tx.To,
+		Value:    value,
+		Data:     tx.Data,
+	}), types.NewEIP155Signer(chainID), key)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("sign transaction: %w", err)
+	}
+
+	if err := client.SendTransaction(ctx, signed); err != nil {
+		return common.Hash{}, fmt.Errorf("send transaction: %w", err)
+	}
+	return signed.Hash(), nil
+}
+
+// privateKey returns the private key of the faucet, or decrypts the keystore of keys created with CreateKey.
+func (c *EthereumChain) privateKey(ctx context.Context, keyName string) (*ecdsa.PrivateKey, error) {
+	if keyName == "faucet" {
+		return crypto.HexToECDSA(strings.TrimPrefix(faucetPrivateKey, "0x"))
+	}
+
+	keystorePath, ok := c.keystoreMap[keyName]
+	if !ok {
+		return nil, fmt.Errorf("no keystore of key %s", keyName)
+	}
+	stdout, _, err := c.Exec(ctx, []string{"cat", keystorePath}, nil)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(stdout, "")
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore: %w", err)
+	}
+	return key.PrivateKey, nil
+}
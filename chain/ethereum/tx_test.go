@@ -0,0 +1,19 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaucetPrivateKey(t *testing.T) {
+	var c EthereumChain
+	key, err := c.privateKey(context.Background(), "faucet")
+	require.NoError(t, err)
+	require.Equal(t, devAccounts[0], crypto.PubkeyToAddress(key.PublicKey))
+
+	_, err = c.privateKey(context.Background(), "missing")
+	require.EqualError(t, err, "no keystore of key missing")
+}
@@ -0,0 +1,102 @@
+package penumbra
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Penumbra encodes keys & IDs with bech32m (BIP-350) without the 90 character length limit of bech32.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const bech32mConst = 0x2bc830a3
+
+// encodeBech32m encodes data with the human readable part hrp.
+func encodeBech32m(hrp string, data []byte) (string, error) {
+	values, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return encodeBech32mValues(hrp, values), nil
+}
+
+// encodeBech32mValues encodes 5 bit values with the human readable part hrp.
+func encodeBech32mValues(hrp string, values []byte) string {
+	checksum := bech32mChecksum(hrp, values)
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range append(values, checksum...) {
+		sb.WriteByte(bech32Charset[v])
+	}
+	return sb.String()
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func bech32mChecksum(hrp string, values []byte) []byte {
+	enc := append(bech32HrpExpand(hrp), values...)
+	enc = append(enc, 0, 0, 0, 0, 0, 0)
+	mod := bech32Polymod(enc) ^ bech32mConst
+
+	checksum := make([]byte, 6)
+	for i := range checksum {
+		checksum[i] = byte((mod >> (5 * (5 - i))) & 31)
+	}
+	return checksum
+}
+
+// convertBits regroups the bits of data from fromBits to toBits sized groups.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc, bits uint
+	maxv := uint(1)<<toBits - 1
+
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, b := range data {
+		if uint(b)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data range: %d", b)
+		}
+		acc = acc<<fromBits | uint(b)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, fmt.Errorf("invalid padding")
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package penumbra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from BIP-350.
+func TestEncodeBech32m(t *testing.T) {
+	require.Equal(t, "a1lqfn3a", encodeBech32mValues("a", []byte{}))
+
+	values := make([]byte, 32)
+	for i := range values {
+		values[i] = byte(31 - i)
+	}
+	require.Equal(t, "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", encodeBech32mValues("abcdef", values))
+
+	// 8 bit data is regrouped into 5 bit values.
+	bz := []byte{0xde, 0xad, 0xbe, 0xef}
+	fiveBit, err := convertBits(bz, 8, 5, true)
+	require.NoError(t, err)
+	eightBit, err := convertBits(fiveBit, 5, 8, false)
+	require.NoError(t, err)
+	require.Equal(t, bz, eightBit)
+
+	encoded, err := encodeBech32m("penumbravalid", bz)
+	require.NoError(t, err)
+	require.Equal(t, encodeBech32mValues("penumbravalid", fiveBit), encoded)
+}
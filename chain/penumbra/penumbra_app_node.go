@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"cosmossdk.io/math"
 	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	dockerclient "github.com/docker/docker/client"
@@ -241,19 +242,40 @@ func (p *PenumbraAppNode) GetAddress(ctx context.Context, keyName string) ([]byt
 	return []byte(addr), nil
 }
 
-// TODO we need to change the func sig to take a denom then filter out the target denom bal from stdout
-func (p *PenumbraAppNode) GetBalance(ctx context.Context, keyName string) (int64, error) {
+// GetBalance returns the balance of denom for the key, as reported by `pcli view balance`.
+// Amounts are summed across all accounts of the key. Use PenumbraClientNode.GetBalance for exact amounts
+// of denoms which pcli displays in a decimal unit.
+func (p *PenumbraAppNode) GetBalance(ctx context.Context, keyName string, denom string) (math.Int, error) {
 	keyPath := filepath.Join(p.HomeDir(), "keys", keyName)
 	pdUrl := fmt.Sprintf("http://%s:8080", p.HostName())
 	cmd := []string{"pcli", "-d", keyPath, "-n", pdUrl, "view", "balance"}
 
 	stdout, _, err := p.Exec(ctx, cmd, nil)
 	if err != nil {
-		return 0, err
+		return math.Int{}, err
 	}
 
-	fmt.Printf("STDOUT BAL: '%s'\n", string(stdout))
-	return 0, nil
+	return parseBalance(string(stdout), denom)
+}
+
+// parseBalance sums the `<amount><denom>` values of denom in the table output of `pcli view balance`.
+func parseBalance(output, denom string) (math.Int, error) {
+	total := math.ZeroInt()
+	for _, field := range strings.Fields(output) {
+		amount, ok := strings.CutSuffix(field, denom)
+		if !ok || amount == "" {
+			continue
+		}
+
+		amt, ok := math.NewIntFromString(amount)
+		if !ok {
+			// Another denom ending with the same suffix, or a decimal display amount.
+			continue
+		}
+		total = total.Add(amt)
+	}
+
+	return total, nil
 }
 
 func (p *PenumbraAppNode) GetAddressBech32m(ctx context.Context, keyName string) (string, error) {
@@ -0,0 +1,56 @@
+package penumbra
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/strangelove-ventures/interchaintest/v8/internal/dockerutil"
+)
+
+// The v1alpha1 TransactionPlannerRequest has no proposal or vote fields, so the view service can not plan
+// governance actions and proposals & votes are submitted with pcli using the key's spend key.
+
+// SubmitProposal submits a governance proposal from the key. proposal is the TOML proposal definition,
+// as generated by `pcli tx proposal template`, including the deposit amount.
+func (p *PenumbraAppNode) SubmitProposal(ctx context.Context, keyName string, proposal []byte) error {
+	fileName := fmt.Sprintf("proposal-%s.toml", keyName)
+	fw := dockerutil.NewFileWriter(p.log, p.DockerClient, p.TestName)
+	if err := fw.WriteFile(ctx, p.VolumeName, fileName, proposal); err != nil {
+		return fmt.Errorf("failed to write proposal file: %w", err)
+	}
+
+	cmd := p.pcliTxCmd(keyName, "proposal", "submit", "--file", filepath.Join(p.HomeDir(), fileName))
+	if _, stderr, err := p.Exec(ctx, cmd, nil); err != nil {
+		return fmt.Errorf("failed to submit proposal: %w (%s)", err, stderr)
+	}
+
+	return nil
+}
+
+// VoteOnProposal votes "yes", "no" or "abstain" on a proposal with the key's delegations.
+func (p *PenumbraAppNode) VoteOnProposal(ctx context.Context, keyName string, proposalID uint64, vote string) error {
+	cmd := p.pcliTxCmd(keyName, "vote", vote, "--on", strconv.FormatUint(proposalID, 10))
+	if _, stderr, err := p.Exec(ctx, cmd, nil); err != nil {
+		return fmt.Errorf("failed to vote on proposal %d: %w (%s)", proposalID, err, stderr)
+	}
+
+	return nil
+}
+
+// WithdrawProposal withdraws a proposal submitted by the key, so its deposit can be claimed once voting ends.
+func (p *PenumbraAppNode) WithdrawProposal(ctx context.Context, keyName string, proposalID uint64, reason string) error {
+	cmd := p.pcliTxCmd(keyName, "proposal", "withdraw", strconv.FormatUint(proposalID, 10), "--reason", reason)
+	if _, stderr, err := p.Exec(ctx, cmd, nil); err != nil {
+		return fmt.Errorf("failed to withdraw proposal %d: %w (%s)", proposalID, err, stderr)
+	}
+
+	return nil
+}
+
+func (p *PenumbraAppNode) pcliTxCmd(keyName string, args ...string) []string {
+	keyPath := filepath.Join(p.HomeDir(), "keys", keyName)
+	pdUrl := fmt.Sprintf("http://%s:8080", p.HostName())
+	return append([]string{"pcli", "-d", keyPath, "-n", pdUrl, "tx"}, args...)
+}
@@ -0,0 +1,28 @@
+package penumbra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBalance(t *testing.T) {
+	output := ` Account  Amount
+ 0        1000upenumbra
+ 0        25transfer/channel-0/uatom
+ 1        500upenumbra
+ 1        1.5penumbra
+`
+
+	bal, err := parseBalance(output, "upenumbra")
+	require.NoError(t, err)
+	require.Equal(t, "1500", bal.String())
+
+	bal, err = parseBalance(output, "transfer/channel-0/uatom")
+	require.NoError(t, err)
+	require.Equal(t, "25", bal.String())
+
+	bal, err = parseBalance(output, "uosmo")
+	require.NoError(t, err)
+	require.True(t, bal.IsZero())
+}
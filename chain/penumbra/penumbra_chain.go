@@ -15,6 +15,8 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/gogoproto/proto"
+	chanTypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/internal/tendermint"
@@ -83,12 +85,49 @@ func NewPenumbraChain(log *zap.Logger, testName string, chainConfig ibc.ChainCon
 	}
 }
 
+// Acknowledgements implements ibc.Chain, returning all acknowledgments in block at height
 func (c *PenumbraChain) Acknowledgements(ctx context.Context, height int64) ([]ibc.PacketAcknowledgement, error) {
-	panic("implement me")
+	var acks []*chanTypes.MsgAcknowledgement
+	err := RangeBlockIbcMessages(ctx, c.getFullNode().TendermintNode.Client, height, func(msg proto.Message) bool {
+		found, ok := msg.(*chanTypes.MsgAcknowledgement)
+		if ok {
+			acks = append(acks, found)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find acknowledgements at height %d: %w", height, err)
+	}
+	ibcAcks := make([]ibc.PacketAcknowledgement, len(acks))
+	for i, ack := range acks {
+		ibcAcks[i] = ibc.PacketAcknowledgement{
+			Acknowledgement: ack.Acknowledgement,
+			Packet:          toIbcPacket(ack.Packet),
+		}
+	}
+	return ibcAcks, nil
 }
 
+// Timeouts implements ibc.Chain, returning all timeouts in block at height
 func (c *PenumbraChain) Timeouts(ctx context.Context, height int64) ([]ibc.PacketTimeout, error) {
-	panic("implement me")
+	var timeouts []*chanTypes.MsgTimeout
+	err := RangeBlockIbcMessages(ctx, c.getFullNode().TendermintNode.Client, height, func(msg proto.Message) bool {
+		found, ok := msg.(*chanTypes.MsgTimeout)
+		if ok {
+			timeouts = append(timeouts, found)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find timeouts at height %d: %w", height, err)
+	}
+	ibcTimeouts := make([]ibc.PacketTimeout, len(timeouts))
+	for i, timeout := range timeouts {
+		ibcTimeouts[i] = ibc.PacketTimeout{
+			Packet: toIbcPacket(timeout.Packet),
+		}
+	}
+	return ibcTimeouts, nil
 }
 
 // Implements Chain interface
@@ -133,12 +133,6 @@ func (p *PenumbraClientNode) GetAddress(ctx context.Context) ([]byte, error) {
 }
 
 func (p *PenumbraClientNode) SendFunds(ctx context.Context, amount ibc.WalletAmount) error {
-	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return err
-	}
-	defer channel.Close()
-
 	hi, lo := translateBigInt(amount.Amount)
 
 	// Generate a transaction plan sending funds to an address.
@@ -156,6 +150,18 @@ func (p *PenumbraClientNode) SendFunds(ctx context.Context, amount ibc.WalletAmo
 		}},
 	}
 
+	return p.planAndBroadcast(ctx, tpr)
+}
+
+// planAndBroadcast has pclientd plan the requested transaction, authorize (sign) it through the custody service,
+// then build and broadcast it through the view service, awaiting its detection.
+func (p *PenumbraClientNode) planAndBroadcast(ctx context.Context, tpr *viewv1alpha1.TransactionPlannerRequest) error {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer channel.Close()
+
 	viewClient := viewv1alpha1.NewViewProtocolServiceClient(channel)
 
 	resp, err := viewClient.TransactionPlanner(ctx, tpr)
@@ -257,7 +263,62 @@ func (p *PenumbraClientNode) GetBalance(ctx context.Context, denom string) (math
 		return math.Int{}, fmt.Errorf("no balance was found for the denom %s", denom)
 	}
 
-	return translateHiAndLo(balances[0].Balance.Amount.Hi, balances[0].Balance.Amount.Lo), nil
+	total := math.ZeroInt()
+	for _, b := range balances {
+		total = total.Add(translateHiAndLo(b.Balance.Amount.Hi, b.Balance.Amount.Lo))
+	}
+
+	return total, nil
+}
+
+// GetBalances returns the balance of every denom held by the account, keyed by the denom's base name.
+func (p *PenumbraClientNode) GetBalances(ctx context.Context) (map[string]math.Int, error) {
+	channel, err := grpc.Dial(
+		p.hostGRPCPort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	viewClient := viewv1alpha1.NewViewProtocolServiceClient(channel)
+
+	balanceRequest := &viewv1alpha1.BalancesRequest{
+		AccountFilter: &cryptov1alpha1.AddressIndex{
+			Account: 0,
+		},
+	}
+
+	balanceStream, err := viewClient.Balances(ctx, balanceRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]math.Int)
+	for {
+		balance, err := balanceStream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		// Balances are only identified by asset ID, the denom is resolved from the asset's metadata.
+		metadata, err := p.GetDenomMetadata(ctx, balance.Balance.AssetId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get denom metadata: %w", err)
+		}
+
+		amount := translateHiAndLo(balance.Balance.Amount.Hi, balance.Balance.Amount.Lo)
+		if prev, ok := balances[metadata.Base]; ok {
+			amount = amount.Add(prev)
+		}
+		balances[metadata.Base] = amount
+	}
+
+	return balances, nil
 }
 
 // translateHiAndLo takes the high and low order bytes and decodes the two uint64 values into the single int128 value
@@ -0,0 +1,254 @@
+package penumbra
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/math"
+	clientv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/client/v1alpha1"
+	cryptov1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/crypto/v1alpha1"
+	dexv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/dex/v1alpha1"
+	viewv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/view/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// LiquidityPosition describes a concentrated liquidity position between two assets.
+type LiquidityPosition struct {
+	Denom1 string
+	Denom2 string
+
+	// P and Q set the position's price, one unit of Denom1 is worth P/Q units of Denom2.
+	P math.Int
+	Q math.Int
+
+	// FeeBPS is the fee charged to trades against the position, in basis points.
+	FeeBPS uint32
+
+	// Reserves1 and Reserves2 are the initial reserves of Denom1 and Denom2.
+	Reserves1 math.Int
+	Reserves2 math.Int
+
+	// CloseOnFill closes the position once it is fully filled in one direction.
+	CloseOnFill bool
+}
+
+// Swap swaps amount of denom for targetDenom. The swap executes in the next batch, its output
+// is received once claimed with ClaimSwaps.
+func (p *PenumbraClientNode) Swap(ctx context.Context, denom string, amount math.Int, targetDenom string) error {
+	hi, lo := translateBigInt(amount)
+
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		Swaps: []*viewv1alpha1.TransactionPlannerRequest_Swap{{
+			Value: &cryptov1alpha1.Value{
+				Amount:  &cryptov1alpha1.Amount{Lo: lo, Hi: hi},
+				AssetId: &cryptov1alpha1.AssetId{AltBaseDenom: denom},
+			},
+			TargetAsset:  &cryptov1alpha1.AssetId{AltBaseDenom: targetDenom},
+			Fee:          &cryptov1alpha1.Fee{Amount: &cryptov1alpha1.Amount{}},
+			ClaimAddress: &cryptov1alpha1.Address{AltBech32M: p.addrString},
+		}},
+	})
+}
+
+// ClaimSwaps claims the outputs of all executed swaps which were not claimed yet, one transaction per swap.
+func (p *PenumbraClientNode) ClaimSwaps(ctx context.Context) error {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer channel.Close()
+
+	viewClient := viewv1alpha1.NewViewProtocolServiceClient(channel)
+	stream, err := viewClient.UnclaimedSwaps(ctx, &viewv1alpha1.UnclaimedSwapsRequest{})
+	if err != nil {
+		return err
+	}
+
+	var swaps []*viewv1alpha1.SwapRecord
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		swaps = append(swaps, resp.Swap)
+	}
+
+	for _, swap := range swaps {
+		if err := p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+			SwapClaims: []*viewv1alpha1.TransactionPlannerRequest_SwapClaim{{
+				SwapCommitment: swap.SwapCommitment,
+			}},
+		}); err != nil {
+			return fmt.Errorf("failed to claim swap: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// OpenPosition opens a liquidity position, funded with the position's reserves.
+func (p *PenumbraClientNode) OpenPosition(ctx context.Context, position LiquidityPosition) error {
+	asset1, err := p.assetID(ctx, position.Denom1)
+	if err != nil {
+		return err
+	}
+	asset2, err := p.assetID(ctx, position.Denom2)
+	if err != nil {
+		return err
+	}
+
+	// Trading pairs are ordered by asset ID, the price & reserves are flipped along with the assets.
+	pp, q, r1, r2 := position.P, position.Q, position.Reserves1, position.Reserves2
+	if bytes.Compare(asset1.Inner, asset2.Inner) > 0 {
+		asset1, asset2 = asset2, asset1
+		pp, q = q, pp
+		r1, r2 = r2, r1
+	}
+
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		PositionOpens: []*viewv1alpha1.TransactionPlannerRequest_PositionOpen{{
+			Position: &dexv1alpha1.Position{
+				Phi: &dexv1alpha1.TradingFunction{
+					Component: &dexv1alpha1.BareTradingFunction{
+						Fee: position.FeeBPS,
+						P:   toAmount(pp),
+						Q:   toAmount(q),
+					},
+					Pair: &dexv1alpha1.TradingPair{Asset_1: asset1, Asset_2: asset2},
+				},
+				Nonce:       nonce,
+				State:       &dexv1alpha1.PositionState{State: dexv1alpha1.PositionState_POSITION_STATE_ENUM_OPENED},
+				Reserves:    &dexv1alpha1.Reserves{R1: toAmount(r1), R2: toAmount(r2)},
+				CloseOnFill: position.CloseOnFill,
+			},
+		}},
+	})
+}
+
+// OwnedPositionIDs returns the IDs of the liquidity positions owned by the account in the state, or in any state
+// if state is unspecified.
+func (p *PenumbraClientNode) OwnedPositionIDs(ctx context.Context, state dexv1alpha1.PositionState_PositionStateEnum) ([]*dexv1alpha1.PositionId, error) {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	req := &viewv1alpha1.OwnedPositionIdsRequest{}
+	if state != dexv1alpha1.PositionState_POSITION_STATE_ENUM_UNSPECIFIED {
+		req.PositionState = &dexv1alpha1.PositionState{State: state}
+	}
+
+	viewClient := viewv1alpha1.NewViewProtocolServiceClient(channel)
+	stream, err := viewClient.OwnedPositionIds(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []*dexv1alpha1.PositionId
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		ids = append(ids, resp.PositionId)
+	}
+
+	return ids, nil
+}
+
+// GetPosition returns the current state of a liquidity position.
+func (p *PenumbraClientNode) GetPosition(ctx context.Context, id *dexv1alpha1.PositionId) (*dexv1alpha1.Position, error) {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	queryClient := clientv1alpha1.NewSpecificQueryServiceClient(channel)
+	resp, err := queryClient.LiquidityPositionById(ctx, &clientv1alpha1.LiquidityPositionByIdRequest{
+		ChainId:    p.Chain.Config().ChainID,
+		PositionId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// ClosePosition closes a liquidity position so it is no longer traded against. Its reserves are
+// received once it is withdrawn with WithdrawPosition.
+func (p *PenumbraClientNode) ClosePosition(ctx context.Context, id *dexv1alpha1.PositionId) error {
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		PositionCloses: []*viewv1alpha1.TransactionPlannerRequest_PositionClose{{
+			PositionId: id,
+		}},
+	})
+}
+
+// WithdrawPosition withdraws the reserves of a closed liquidity position.
+func (p *PenumbraClientNode) WithdrawPosition(ctx context.Context, id *dexv1alpha1.PositionId) error {
+	position, err := p.GetPosition(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get position: %w", err)
+	}
+
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		PositionWithdraws: []*viewv1alpha1.TransactionPlannerRequest_PositionWithdraw{{
+			PositionId:  id,
+			Reserves:    position.Reserves,
+			TradingPair: position.Phi.Pair,
+		}},
+	})
+}
+
+// assetID resolves the asset ID of a base denom from the assets known to pclientd.
+func (p *PenumbraClientNode) assetID(ctx context.Context, denom string) (*cryptov1alpha1.AssetId, error) {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	viewClient := viewv1alpha1.NewViewProtocolServiceClient(channel)
+	stream, err := viewClient.Assets(ctx, &viewv1alpha1.AssetsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if resp.DenomMetadata.Base == denom && resp.DenomMetadata.PenumbraAssetId != nil {
+			return resp.DenomMetadata.PenumbraAssetId, nil
+		}
+	}
+
+	return nil, fmt.Errorf("asset %s not found", denom)
+}
+
+func toAmount(i math.Int) *cryptov1alpha1.Amount {
+	hi, lo := translateBigInt(i)
+	return &cryptov1alpha1.Amount{Lo: lo, Hi: hi}
+}
@@ -0,0 +1,121 @@
+package penumbra
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/math"
+	clientv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/client/v1alpha1"
+	cryptov1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/crypto/v1alpha1"
+	stakev1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/stake/v1alpha1"
+	viewv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/view/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// identityKeyPrefix is the bech32m prefix of validator identity keys.
+const identityKeyPrefix = "penumbravalid"
+
+// DelegationDenom returns the base denom of the delegation token of the validator with the identity key.
+func DelegationDenom(identityKey *cryptov1alpha1.IdentityKey) (string, error) {
+	ik, err := encodeBech32m(identityKeyPrefix, identityKey.Ik)
+	if err != nil {
+		return "", err
+	}
+	return "udelegation_" + ik, nil
+}
+
+// Validators returns the info of the chain's active validators, or of all validators if showInactive is set.
+func (p *PenumbraClientNode) Validators(ctx context.Context, showInactive bool) ([]*stakev1alpha1.ValidatorInfo, error) {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	queryClient := clientv1alpha1.NewObliviousQueryServiceClient(channel)
+	stream, err := queryClient.ValidatorInfo(ctx, &clientv1alpha1.ValidatorInfoRequest{
+		ChainId:      p.Chain.Config().ChainID,
+		ShowInactive: showInactive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []*stakev1alpha1.ValidatorInfo
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		validators = append(validators, resp.ValidatorInfo)
+	}
+
+	return validators, nil
+}
+
+// Delegate delegates amount of the staking token to the validator with the identity key.
+func (p *PenumbraClientNode) Delegate(ctx context.Context, identityKey *cryptov1alpha1.IdentityKey, amount math.Int) error {
+	rate, err := p.currentValidatorRate(ctx, identityKey)
+	if err != nil {
+		return err
+	}
+
+	hi, lo := translateBigInt(amount)
+
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		Delegations: []*viewv1alpha1.TransactionPlannerRequest_Delegate{{
+			Amount:   &cryptov1alpha1.Amount{Lo: lo, Hi: hi},
+			RateData: rate,
+		}},
+	})
+}
+
+// Undelegate undelegates amount of the delegation token of the validator with the identity key.
+// The staking tokens are received as unbonding tokens, which are claimable after the unbonding period.
+func (p *PenumbraClientNode) Undelegate(ctx context.Context, identityKey *cryptov1alpha1.IdentityKey, amount math.Int) error {
+	rate, err := p.currentValidatorRate(ctx, identityKey)
+	if err != nil {
+		return err
+	}
+
+	denom, err := DelegationDenom(identityKey)
+	if err != nil {
+		return err
+	}
+
+	hi, lo := translateBigInt(amount)
+
+	return p.planAndBroadcast(ctx, &viewv1alpha1.TransactionPlannerRequest{
+		Undelegations: []*viewv1alpha1.TransactionPlannerRequest_Undelegate{{
+			Value: &cryptov1alpha1.Value{
+				Amount:  &cryptov1alpha1.Amount{Lo: lo, Hi: hi},
+				AssetId: &cryptov1alpha1.AssetId{AltBaseDenom: denom},
+			},
+			RateData: rate,
+		}},
+	})
+}
+
+func (p *PenumbraClientNode) currentValidatorRate(ctx context.Context, identityKey *cryptov1alpha1.IdentityKey) (*stakev1alpha1.RateData, error) {
+	channel, err := grpc.Dial(p.hostGRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer channel.Close()
+
+	queryClient := clientv1alpha1.NewSpecificQueryServiceClient(channel)
+	resp, err := queryClient.CurrentValidatorRate(ctx, &clientv1alpha1.CurrentValidatorRateRequest{
+		ChainId:     p.Chain.Config().ChainID,
+		IdentityKey: identityKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query validator rate: %w", err)
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,74 @@
+package penumbra
+
+import (
+	"context"
+	"fmt"
+
+	tmtypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/gogoproto/proto"
+	chanTypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	transactionv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/transaction/v1alpha1"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+type blockClient interface {
+	Block(ctx context.Context, height *int64) (*tmtypes.ResultBlock, error)
+}
+
+// ibcMsgTypes are the IBC messages decoded from a Penumbra IbcAction, keyed by their type URL.
+var ibcMsgTypes = map[string]func() proto.Message{
+	"/ibc.core.channel.v1.MsgRecvPacket":      func() proto.Message { return &chanTypes.MsgRecvPacket{} },
+	"/ibc.core.channel.v1.MsgAcknowledgement": func() proto.Message { return &chanTypes.MsgAcknowledgement{} },
+	"/ibc.core.channel.v1.MsgTimeout":         func() proto.Message { return &chanTypes.MsgTimeout{} },
+}
+
+// RangeBlockIbcMessages iterates through all a block's transactions and yields the packet related IBC messages
+// (MsgRecvPacket, MsgAcknowledgement & MsgTimeout) of each transaction's IBC actions to f.
+// Return true from f to stop iteration.
+func RangeBlockIbcMessages(ctx context.Context, client blockClient, height int64, done func(proto.Message) bool) error {
+	h := int64(height)
+	block, err := client.Block(ctx, &h)
+	if err != nil {
+		return fmt.Errorf("tendermint rpc get block: %w", err)
+	}
+	for _, txbz := range block.Block.Txs {
+		var tx transactionv1alpha1.Transaction
+		if err := tx.Unmarshal(txbz); err != nil {
+			return fmt.Errorf("decode penumbra tx: %w", err)
+		}
+		for _, action := range tx.GetBody().GetActions() {
+			raw := action.GetIbcAction().GetRawAction()
+			if raw == nil {
+				continue
+			}
+
+			newMsg, ok := ibcMsgTypes[raw.TypeUrl]
+			if !ok {
+				continue
+			}
+
+			msg := newMsg()
+			if err := proto.Unmarshal(raw.Value, msg); err != nil {
+				return fmt.Errorf("decode %s: %w", raw.TypeUrl, err)
+			}
+
+			if ok := done(msg); ok {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func toIbcPacket(p chanTypes.Packet) ibc.Packet {
+	return ibc.Packet{
+		Sequence:         p.Sequence,
+		SourcePort:       p.SourcePort,
+		SourceChannel:    p.SourceChannel,
+		DestPort:         p.DestinationPort,
+		DestChannel:      p.DestinationChannel,
+		Data:             p.Data,
+		TimeoutHeight:    p.TimeoutHeight.String(),
+		TimeoutTimestamp: ibc.Nanoseconds(p.TimeoutTimestamp),
+	}
+}
@@ -0,0 +1,92 @@
+package penumbra
+
+import (
+	"context"
+	"testing"
+
+	tmtypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	chanTypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/ibc/v1alpha1"
+	transactionv1alpha1 "github.com/strangelove-ventures/interchaintest/v8/chain/penumbra/core/transaction/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBlockClient struct {
+	txs []cmttypes.Tx
+}
+
+func (m mockBlockClient) Block(ctx context.Context, height *int64) (*tmtypes.ResultBlock, error) {
+	return &tmtypes.ResultBlock{
+		Block: &cmttypes.Block{Data: cmttypes.Data{Txs: m.txs}},
+	}, nil
+}
+
+func ibcTx(t *testing.T, msgs ...proto.Message) cmttypes.Tx {
+	t.Helper()
+
+	var actions []*transactionv1alpha1.Action
+	for _, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+
+		actions = append(actions, &transactionv1alpha1.Action{
+			Action: &transactionv1alpha1.Action_IbcAction{
+				IbcAction: &ibcv1alpha1.IbcAction{RawAction: any},
+			},
+		})
+	}
+
+	tx := transactionv1alpha1.Transaction{
+		Body: &transactionv1alpha1.TransactionBody{Actions: actions},
+	}
+	bz, err := tx.Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func TestRangeBlockIbcMessages(t *testing.T) {
+	packet := chanTypes.Packet{
+		Sequence:           3,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+		Data:               []byte("data"),
+		TimeoutTimestamp:   100,
+	}
+
+	client := mockBlockClient{txs: []cmttypes.Tx{
+		ibcTx(t, &chanTypes.MsgAcknowledgement{Packet: packet, Acknowledgement: []byte("ack")}),
+		ibcTx(t, &chanTypes.MsgChannelOpenInit{PortId: "transfer"}, &chanTypes.MsgTimeout{Packet: packet}),
+	}}
+
+	var msgs []proto.Message
+	err := RangeBlockIbcMessages(context.Background(), client, 1, func(msg proto.Message) bool {
+		msgs = append(msgs, msg)
+		return false
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	ack, ok := msgs[0].(*chanTypes.MsgAcknowledgement)
+	require.True(t, ok)
+	require.Equal(t, []byte("ack"), ack.Acknowledgement)
+	require.Equal(t, "channel-1", toIbcPacket(ack.Packet).DestChannel)
+
+	timeout, ok := msgs[1].(*chanTypes.MsgTimeout)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), toIbcPacket(timeout.Packet).Sequence)
+
+	// Iteration stops once done returns true.
+	msgs = nil
+	err = RangeBlockIbcMessages(context.Background(), client, 1, func(msg proto.Message) bool {
+		msgs = append(msgs, msg)
+		return true
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+}
@@ -0,0 +1,153 @@
+package polkadot
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/blockdb"
+)
+
+// pallet-ibc events, as returned by the ibc_queryEvents RPC. Each event is a single key object
+// mapping the event type to its fields, e.g. {"SendPacket": {"height": {...}, "packet": {...}}}.
+const (
+	ibcEventAcknowledgePacket    = "AcknowledgePacket"
+	ibcEventTimeoutPacket        = "TimeoutPacket"
+	ibcEventTimeoutOnClosePacket = "TimeoutOnClosePacket"
+)
+
+type ibcHeight struct {
+	RevisionNumber uint64 `json:"revision_number"`
+	RevisionHeight uint64 `json:"revision_height"`
+}
+
+type ibcTimestamp struct {
+	Time *time.Time `json:"time"`
+}
+
+type ibcPacket struct {
+	Sequence           uint64       `json:"sequence"`
+	SourcePort         string       `json:"source_port"`
+	SourceChannel      string       `json:"source_channel"`
+	DestinationPort    string       `json:"destination_port"`
+	DestinationChannel string       `json:"destination_channel"`
+	Data               string       `json:"data"`
+	TimeoutHeight      ibcHeight    `json:"timeout_height"`
+	TimeoutTimestamp   ibcTimestamp `json:"timeout_timestamp"`
+}
+
+type ibcPacketEvent struct {
+	Packet ibcPacket `json:"packet"`
+}
+
+// toIbcPacket converts a pallet-ibc packet, whose data is hex encoded, to an ibc.Packet.
+func (p ibcPacket) toIbcPacket() (ibc.Packet, error) {
+	data, err := hex.DecodeString(p.Data)
+	if err != nil {
+		return ibc.Packet{}, fmt.Errorf("invalid packet data: %w", err)
+	}
+
+	var timeout ibc.Nanoseconds
+	if p.TimeoutTimestamp.Time != nil {
+		timeout = ibc.Nanoseconds(p.TimeoutTimestamp.Time.UnixNano())
+	}
+
+	return ibc.Packet{
+		Sequence:         p.Sequence,
+		SourcePort:       p.SourcePort,
+		SourceChannel:    p.SourceChannel,
+		DestPort:         p.DestinationPort,
+		DestChannel:      p.DestinationChannel,
+		Data:             data,
+		TimeoutHeight:    fmt.Sprintf("%d-%d", p.TimeoutHeight.RevisionNumber, p.TimeoutHeight.RevisionHeight),
+		TimeoutTimestamp: timeout,
+	}, nil
+}
+
+// rangeIbcEvents calls fn with the type & raw fields of each event, in order.
+func rangeIbcEvents(events gstypes.IBCEventsQueryResult, fn func(eventType string, fields json.RawMessage) error) error {
+	for _, event := range events {
+		for eventType, fields := range event {
+			bz, err := json.Marshal(fields)
+			if err != nil {
+				return err
+			}
+			if err := fn(eventType, bz); err != nil {
+				return fmt.Errorf("%s: %w", eventType, err)
+			}
+		}
+	}
+	return nil
+}
+
+// packetsFromIbcEvents returns the packets of the events of any of the event types.
+func packetsFromIbcEvents(events gstypes.IBCEventsQueryResult, eventTypes ...string) ([]ibc.Packet, error) {
+	var packets []ibc.Packet
+	err := rangeIbcEvents(events, func(eventType string, fields json.RawMessage) error {
+		match := false
+		for _, t := range eventTypes {
+			match = match || t == eventType
+		}
+		if !match {
+			return nil
+		}
+
+		var event ibcPacketEvent
+		if err := json.Unmarshal(fields, &event); err != nil {
+			return err
+		}
+		packet, err := event.Packet.toIbcPacket()
+		if err != nil {
+			return err
+		}
+		packets = append(packets, packet)
+		return nil
+	})
+	return packets, err
+}
+
+// ibcEventsToBlockdb converts the events to blockdb events, nested fields are flattened into
+// attributes joined with underscores, e.g. packet_source_channel.
+func ibcEventsToBlockdb(events gstypes.IBCEventsQueryResult) ([]blockdb.Event, error) {
+	var out []blockdb.Event
+	err := rangeIbcEvents(events, func(eventType string, fields json.RawMessage) error {
+		var v interface{}
+		if err := json.Unmarshal(fields, &v); err != nil {
+			return err
+		}
+		var attrs []blockdb.EventAttribute
+		flattenAttributes("", v, &attrs)
+		out = append(out, blockdb.Event{Type: eventType, Attributes: attrs})
+		return nil
+	})
+	return out, err
+}
+
+func flattenAttributes(prefix string, v interface{}, attrs *[]blockdb.EventAttribute) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			key := k
+			if prefix != "" {
+				key = prefix + "_" + k
+			}
+			flattenAttributes(key, v[k], attrs)
+		}
+	case nil:
+		*attrs = append(*attrs, blockdb.EventAttribute{Key: prefix})
+	case string:
+		*attrs = append(*attrs, blockdb.EventAttribute{Key: prefix, Value: v})
+	default:
+		bz, _ := json.Marshal(v)
+		*attrs = append(*attrs, blockdb.EventAttribute{Key: prefix, Value: string(bz)})
+	}
+}
@@ -0,0 +1,58 @@
+package polkadot
+
+import (
+	"encoding/json"
+	"testing"
+
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleIbcEvents = `[
+	{"SendPacket":{"height":{"revision_number":0,"revision_height":10},"packet":{"sequence":1,"source_port":"transfer","source_channel":"channel-0","destination_port":"transfer","destination_channel":"channel-1","data":"7B7D","timeout_height":{"revision_number":0,"revision_height":0},"timeout_timestamp":{"time":"1970-01-01T00:00:10Z"}}}},
+	{"AcknowledgePacket":{"height":{"revision_number":0,"revision_height":12},"packet":{"sequence":1,"source_port":"transfer","source_channel":"channel-0","destination_port":"transfer","destination_channel":"channel-1","data":"","timeout_height":{"revision_number":1,"revision_height":100},"timeout_timestamp":{"time":null}}}},
+	{"TimeoutOnClosePacket":{"height":{"revision_number":0,"revision_height":12},"packet":{"sequence":2,"source_port":"transfer","source_channel":"channel-0","destination_port":"transfer","destination_channel":"channel-1","data":"","timeout_height":{"revision_number":0,"revision_height":0},"timeout_timestamp":{"time":"1970-01-01T00:00:10Z"}}}}
+]`
+
+func TestPacketsFromIbcEvents(t *testing.T) {
+	var events gstypes.IBCEventsQueryResult
+	require.NoError(t, json.Unmarshal([]byte(sampleIbcEvents), &events))
+
+	acks, err := packetsFromIbcEvents(events, ibcEventAcknowledgePacket)
+	require.NoError(t, err)
+	require.Equal(t, []ibc.Packet{{
+		Sequence:      1,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		DestPort:      "transfer",
+		DestChannel:   "channel-1",
+		Data:          []byte{},
+		TimeoutHeight: "1-100",
+	}}, acks)
+
+	timeouts, err := packetsFromIbcEvents(events, ibcEventTimeoutPacket, ibcEventTimeoutOnClosePacket)
+	require.NoError(t, err)
+	require.Len(t, timeouts, 1)
+	require.Equal(t, uint64(2), timeouts[0].Sequence)
+	require.Equal(t, ibc.Nanoseconds(10_000_000_000), timeouts[0].TimeoutTimestamp)
+
+	sends, err := packetsFromIbcEvents(events, "SendPacket")
+	require.NoError(t, err)
+	require.Len(t, sends, 1)
+	require.Equal(t, []byte("{}"), sends[0].Data)
+}
+
+func TestIbcEventsToBlockdb(t *testing.T) {
+	var events gstypes.IBCEventsQueryResult
+	require.NoError(t, json.Unmarshal([]byte(sampleIbcEvents), &events))
+
+	out, err := ibcEventsToBlockdb(events)
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+	require.Equal(t, "SendPacket", out[0].Type)
+	require.Contains(t, out[0].Attributes, blockdb.EventAttribute{Key: "packet_source_channel", Value: "channel-0"})
+	require.Contains(t, out[0].Attributes, blockdb.EventAttribute{Key: "packet_sequence", Value: "1"})
+	require.Contains(t, out[1].Attributes, blockdb.EventAttribute{Key: "packet_timeout_timestamp_time"})
+}
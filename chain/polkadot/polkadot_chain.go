@@ -71,6 +71,19 @@ type ParachainConfig struct {
 	NumNodes        int
 	Flags           []string
 	RelayChainFlags []string
+
+	// HrmpChannels are the outbound HRMP channels from this parachain opened at genesis.
+	HrmpChannels []HrmpChannelConfig
+}
+
+// HrmpChannelConfig configures an HRMP channel to another parachain.
+type HrmpChannelConfig struct {
+	// Recipient is the ChainID of the receiving parachain's ParachainConfig.
+	Recipient string
+
+	// MaxCapacity and MaxMessageSize default to 8 messages of 102400 bytes.
+	MaxCapacity    uint32
+	MaxMessageSize uint32
 }
 
 // IndexedName is a slice of the substrate dev key names used for key derivation.
@@ -374,13 +387,15 @@ func (c *PolkadotChain) modifyRelayChainGenesis(ctx context.Context, chainSpec i
 		return fmt.Errorf("error setting validation upgrade delay: %w", err)
 	}
 	parachains := [][]interface{}{}
+	parachainIDs := make(map[string]int, len(c.ParachainNodes))
 
-	for _, parachainNodes := range c.ParachainNodes {
+	for i, parachainNodes := range c.ParachainNodes {
 		firstParachainNode := parachainNodes[0]
 		parachainID, err := firstParachainNode.ParachainID(ctx)
 		if err != nil {
 			return fmt.Errorf("error getting parachain ID: %w", err)
 		}
+		parachainIDs[c.parachainConfig[i].ChainID] = parachainID
 		genesisState, err := firstParachainNode.ExportGenesisState(ctx)
 		if err != nil {
 			return fmt.Errorf("error exporting genesis state: %w", err)
@@ -401,12 +416,78 @@ func (c *PolkadotChain) modifyRelayChainGenesis(ctx context.Context, chainSpec i
 	if err := dyno.Set(chainSpec, parachains, runtimeGenesisPath("paras", "paras")...); err != nil {
 		return fmt.Errorf("error setting parachains: %w", err)
 	}
+	if err := c.setHrmpChannels(chainSpec, parachainIDs); err != nil {
+		return err
+	}
 	if err := dyno.Set(chainSpec, 20, "genesis", "runtime", "session_length_in_blocks"); err != nil {
 		return fmt.Errorf("error setting session_length_in_blocks: %w", err)
 	}
 	return nil
 }
 
+// setHrmpChannels pre-opens the configured HRMP channels, raising the relay chain's HRMP limits where needed.
+func (c *PolkadotChain) setHrmpChannels(chainSpec interface{}, parachainIDs map[string]int) error {
+	channels := [][]interface{}{}
+	var maxCapacity, maxMessageSize, maxTotalSize uint64
+	outbound := map[int]uint64{}
+	inbound := map[int]uint64{}
+	var maxOutbound, maxInbound uint64
+
+	for i, pc := range c.parachainConfig {
+		sender := parachainIDs[pc.ChainID]
+		for _, hc := range pc.HrmpChannels {
+			recipient, ok := parachainIDs[hc.Recipient]
+			if !ok {
+				return fmt.Errorf("hrmp channel from parachain %d: unknown recipient %s", i, hc.Recipient)
+			}
+			capacity, messageSize := uint64(hc.MaxCapacity), uint64(hc.MaxMessageSize)
+			if capacity == 0 {
+				capacity = 8
+			}
+			if messageSize == 0 {
+				messageSize = 102400
+			}
+			channels = append(channels, []interface{}{sender, recipient, capacity, messageSize})
+
+			maxCapacity = max(maxCapacity, capacity)
+			maxMessageSize = max(maxMessageSize, messageSize)
+			maxTotalSize = max(maxTotalSize, capacity*messageSize)
+			outbound[sender]++
+			inbound[recipient]++
+			maxOutbound = max(maxOutbound, outbound[sender])
+			maxInbound = max(maxInbound, inbound[recipient])
+		}
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	if err := dyno.Set(chainSpec, channels, runtimeGenesisPath("hrmp", "preopenHrmpChannels")...); err != nil {
+		return fmt.Errorf("error setting hrmp channels: %w", err)
+	}
+	limits := []struct {
+		name  string
+		value uint64
+	}{
+		{"hrmp_channel_max_capacity", maxCapacity},
+		{"hrmp_channel_max_message_size", maxMessageSize},
+		{"hrmp_channel_max_total_size", maxTotalSize},
+		{"hrmp_max_parachain_outbound_channels", maxOutbound},
+		{"hrmp_max_parachain_inbound_channels", maxInbound},
+		{"hrmp_max_message_num_per_candidate", maxCapacity},
+	}
+	for _, l := range limits {
+		path := runtimeGenesisPath("configuration", "config", l.name)
+		if current, err := dyno.GetFloat64(chainSpec, path...); err == nil && uint64(current) >= l.value {
+			continue
+		}
+		if err := dyno.Set(chainSpec, l.value, path...); err != nil {
+			return fmt.Errorf("error setting %s: %w", l.name, err)
+		}
+	}
+	return nil
+}
+
 func (c *PolkadotChain) logger() *zap.Logger {
 	return c.log.With(
 		zap.String("chain_id", c.cfg.ChainID),
@@ -607,7 +688,7 @@ func (c *PolkadotChain) Height(ctx context.Context) (int64, error) {
 // ExportState exports the chain state at specific height.
 // Implements Chain interface.
 func (c *PolkadotChain) ExportState(ctx context.Context, height int64) (string, error) {
-	panic("[ExportState] not implemented yet")
+	return ExportState(ctx, c.ParachainNodes[0][0].api, height)
 }
 
 // HomeDir is the home directory of a node running in a docker container. Therefore, this maps to
@@ -816,13 +897,40 @@ func (c *PolkadotChain) GetGasFeesInNativeDenom(gasPaid int64) int64 {
 // Acknowledgements returns all acknowledgements in a block at height.
 // Implements Chain interface.
 func (c *PolkadotChain) Acknowledgements(ctx context.Context, height int64) ([]ibc.PacketAcknowledgement, error) {
-	panic("[Acknowledgements] not implemented yet")
+	events, err := IbcEvents(ctx, c.ParachainNodes[0][0].api, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ibc events: %w", err)
+	}
+	packets, err := packetsFromIbcEvents(events, ibcEventAcknowledgePacket)
+	if err != nil {
+		return nil, err
+	}
+
+	// pallet-ibc does not include the acknowledgement in the AcknowledgePacket event.
+	acks := make([]ibc.PacketAcknowledgement, len(packets))
+	for i, packet := range packets {
+		acks[i] = ibc.PacketAcknowledgement{Packet: packet}
+	}
+	return acks, nil
 }
 
 // Timeouts returns all timeouts in a block at height.
 // Implements Chain interface.
 func (c *PolkadotChain) Timeouts(ctx context.Context, height int64) ([]ibc.PacketTimeout, error) {
-	panic("[Timeouts] not implemented yet")
+	events, err := IbcEvents(ctx, c.ParachainNodes[0][0].api, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ibc events: %w", err)
+	}
+	packets, err := packetsFromIbcEvents(events, ibcEventTimeoutPacket, ibcEventTimeoutOnClosePacket)
+	if err != nil {
+		return nil, err
+	}
+
+	timeouts := make([]ibc.PacketTimeout, len(packets))
+	for i, packet := range packets {
+		timeouts[i] = ibc.PacketTimeout{Packet: packet}
+	}
+	return timeouts, nil
 }
 
 // GetKeyringPair returns the keyring pair from the keyring using keyName
@@ -841,9 +949,9 @@ func (c *PolkadotChain) GetKeyringPair(keyName string) (signature.KeyringPair, e
 	return kp, nil
 }
 
-// FindTxs implements blockdb.BlockSaver (Not implemented yet for polkadot, but we don't want to exit)
+// FindTxs implements blockdb.BlockSaver, returning the parachain's extrinsics and pallet-ibc events at height.
 func (c *PolkadotChain) FindTxs(ctx context.Context, height int64) ([]blockdb.Tx, error) {
-	return []blockdb.Tx{}, nil
+	return FindTxs(ctx, c.ParachainNodes[0][0].api, height)
 }
 
 // GetIbcBalance returns the Coins type of ibc coins in account
@@ -1,9 +1,15 @@
 package polkadot
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
 	"cosmossdk.io/math"
 	gsrpc "github.com/misko9/go-substrate-rpc-client/v4"
 	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/misko9/go-substrate-rpc-client/v4/types/codec"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/blockdb"
 )
 
 // GetBalance fetches the current balance for a specific account address using the SubstrateAPI
@@ -32,3 +38,98 @@ func GetBalance(api *gsrpc.SubstrateAPI, address string) (math.Int, error) {
 
 	return math.NewIntFromBigInt(accountInfo.Data.Free.Int), nil
 }
+
+// IbcEvents fetches the pallet-ibc events emitted in the block at height using the SubstrateAPI
+func IbcEvents(ctx context.Context, api *gsrpc.SubstrateAPI, height int64) (gstypes.IBCEventsQueryResult, error) {
+	return api.RPC.IBC.QueryIbcEvents(ctx, []gstypes.BlockNumberOrHash{{Number: uint32(height)}})
+}
+
+// FindTxs returns the extrinsics of the block at height using the SubstrateAPI. pallet-ibc events
+// can not be attributed to an extrinsic, so they are returned in an additional artificial transaction.
+func FindTxs(ctx context.Context, api *gsrpc.SubstrateAPI, height int64) ([]blockdb.Tx, error) {
+	hash, err := api.RPC.Chain.GetBlockHash(uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.RPC.Chain.GetBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]blockdb.Tx, 0, len(block.Block.Extrinsics)+1)
+	for _, ext := range block.Block.Extrinsics {
+		encoded, err := codec.EncodeToHex(ext)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"section":   ext.Method.CallIndex.SectionIndex,
+			"method":    ext.Method.CallIndex.MethodIndex,
+			"signed":    ext.IsSigned(),
+			"extrinsic": encoded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, blockdb.Tx{Data: data})
+	}
+
+	ibcEvents, err := IbcEvents(ctx, api, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(ibcEvents) > 0 {
+		events, err := ibcEventsToBlockdb(ibcEvents)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, blockdb.Tx{
+			Data:   []byte(`{"data":"ibc_events","note":"this is a transaction artificially created for debugging purposes"}`),
+			Events: events,
+		})
+	}
+
+	return txs, nil
+}
+
+// ExportState exports the raw storage of the block at height using the SubstrateAPI, in the
+// genesis format of a raw chain spec. Requires the node to allow unsafe RPC methods.
+func ExportState(ctx context.Context, api *gsrpc.SubstrateAPI, height int64) (string, error) {
+	hash, err := api.RPC.Chain.GetBlockHash(uint64(height))
+	if err != nil {
+		return "", err
+	}
+
+	var pairs [][2]string
+	if err := api.Client.CallContext(ctx, &pairs, "state_getPairs", "0x", hash.Hex()); err != nil {
+		return "", fmt.Errorf("failed to get storage pairs: %w", err)
+	}
+
+	top := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		top[pair[0]] = pair[1]
+	}
+
+	state := map[string]interface{}{
+		"genesis": map[string]interface{}{
+			"raw": map[string]interface{}{
+				"top":             top,
+				"childrenDefault": map[string]interface{}{},
+			},
+		},
+	}
+	bz, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// SpecVersion fetches the spec version of the current runtime using the SubstrateAPI
+func SpecVersion(api *gsrpc.SubstrateAPI) (uint32, error) {
+	rv, err := api.RPC.State.GetRuntimeVersionLatest()
+	if err != nil {
+		return 0, err
+	}
+	return uint32(rv.SpecVersion), nil
+}
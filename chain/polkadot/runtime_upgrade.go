@@ -0,0 +1,133 @@
+package polkadot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gsrpc "github.com/misko9/go-substrate-rpc-client/v4"
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/blake2b"
+)
+
+// runtimeUpgradeTimeout is how long to wait for a runtime upgrade to be enacted. Parachain upgrades
+// are only applied after the relay chain's validation upgrade delay.
+const runtimeUpgradeTimeout = 5 * time.Minute
+
+// RelayChainSudo dispatches a call on the relay chain with root origin. keyName must be the sudo key,
+// the first relay chain node's account ("alice") by default.
+func (c *PolkadotChain) RelayChainSudo(ctx context.Context, keyName string, method string, args ...interface{}) error {
+	kp, err := c.GetKeyringPair(keyName)
+	if err != nil {
+		return err
+	}
+	hash, err := SudoTx(c.RelayChainNodes[0].api, kp, method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to submit sudo %s: %w", method, err)
+	}
+	c.log.Info("Relay chain sudo call sent", zap.String("method", method), zap.String("hash", fmt.Sprintf("%#x", hash)))
+	return nil
+}
+
+// ParachainSudo dispatches a call on the parachain with root origin. keyName must be the parachain's sudo key.
+func (c *PolkadotChain) ParachainSudo(ctx context.Context, keyName string, method string, args ...interface{}) error {
+	kp, err := c.GetKeyringPair(keyName)
+	if err != nil {
+		return err
+	}
+	hash, err := SudoTx(c.ParachainNodes[0][0].api, kp, method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to submit sudo %s: %w", method, err)
+	}
+	c.log.Info("Parachain sudo call sent", zap.String("method", method), zap.String("hash", fmt.Sprintf("%#x", hash)))
+	return nil
+}
+
+// RelayChainSpecVersion returns the spec version of the relay chain's current runtime.
+func (c *PolkadotChain) RelayChainSpecVersion(ctx context.Context) (uint32, error) {
+	return SpecVersion(c.RelayChainNodes[0].api)
+}
+
+// ParachainSpecVersion returns the spec version of the parachain's current runtime.
+func (c *PolkadotChain) ParachainSpecVersion(ctx context.Context) (uint32, error) {
+	return SpecVersion(c.ParachainNodes[0][0].api)
+}
+
+// UpgradeRelayChainRuntime replaces the relay chain runtime with the wasm code using sudo System.set_code,
+// then waits until the runtime reports specVersion.
+func (c *PolkadotChain) UpgradeRelayChainRuntime(ctx context.Context, keyName string, code []byte, specVersion uint32) error {
+	kp, err := c.GetKeyringPair(keyName)
+	if err != nil {
+		return err
+	}
+
+	api := c.RelayChainNodes[0].api
+	hash, err := SetCodeTx(api, kp, code)
+	if err != nil {
+		return fmt.Errorf("failed to submit set_code: %w", err)
+	}
+	c.log.Info("Relay chain runtime upgrade sent", zap.String("hash", fmt.Sprintf("%#x", hash)))
+
+	return waitForSpecVersion(api, specVersion)
+}
+
+// UpgradeParachainRuntime upgrades the parachain runtime to the wasm code by authorizing the code hash with
+// sudo ParachainSystem.authorize_upgrade and then enacting it with ParachainSystem.enact_authorized_upgrade.
+// It waits until the relay chain has applied the upgrade and the runtime reports specVersion.
+func (c *PolkadotChain) UpgradeParachainRuntime(ctx context.Context, keyName string, code []byte, specVersion uint32) error {
+	kp, err := c.GetKeyringPair(keyName)
+	if err != nil {
+		return err
+	}
+
+	api := c.ParachainNodes[0][0].api
+	codeHash := blake2b.Sum256(code)
+	if _, err := AuthorizeUpgradeTx(api, kp, gstypes.NewHash(codeHash[:]), true); err != nil {
+		return fmt.Errorf("failed to authorize upgrade: %w", err)
+	}
+
+	if err := waitForStorage(api, "ParachainSystem", "AuthorizedUpgrade"); err != nil {
+		return fmt.Errorf("upgrade was not authorized: %w", err)
+	}
+
+	hash, err := EnactAuthorizedUpgradeTx(api, kp, code)
+	if err != nil {
+		return fmt.Errorf("failed to enact authorized upgrade: %w", err)
+	}
+	c.log.Info("Parachain runtime upgrade sent", zap.String("hash", fmt.Sprintf("%#x", hash)))
+
+	return waitForSpecVersion(api, specVersion)
+}
+
+// waitForSpecVersion polls the runtime version until it reports specVersion.
+func waitForSpecVersion(api *gsrpc.SubstrateAPI, specVersion uint32) error {
+	return testutil.WaitForCondition(runtimeUpgradeTimeout, 2*time.Second, func() (bool, error) {
+		v, err := SpecVersion(api)
+		if err != nil {
+			return false, err
+		}
+		return v == specVersion, nil
+	})
+}
+
+// waitForStorage polls until the storage value of the pallet item is set.
+func waitForStorage(api *gsrpc.SubstrateAPI, pallet, item string) error {
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return err
+	}
+	key, err := gstypes.CreateStorageKey(meta, pallet, item)
+	if err != nil {
+		return err
+	}
+
+	return testutil.WaitForCondition(runtimeUpgradeTimeout, 2*time.Second, func() (bool, error) {
+		raw, err := api.RPC.State.GetStorageRawLatest(key)
+		if err != nil {
+			return false, err
+		}
+		return raw != nil && len(*raw) > 0, nil
+	})
+}
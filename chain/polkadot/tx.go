@@ -39,23 +39,7 @@ func SendFundsTx(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair, a
 
 // Turns on sending and receiving ibc transfers
 func EnableIbc(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair) (gstypes.Hash, error) {
-	hash := gstypes.Hash{}
-	meta, err := api.RPC.State.GetMetadataLatest()
-	if err != nil {
-		return hash, err
-	}
-
-	c, err := gstypes.NewCall(meta, "Ibc.set_params", gstypes.NewBool(true), gstypes.NewBool(true))
-	if err != nil {
-		return hash, err
-	}
-
-	sc, err := gstypes.NewCall(meta, "Sudo.sudo", c)
-	if err != nil {
-		return hash, err
-	}
-
-	return CreateSignSubmitExt(api, meta, senderKeypair, sc)
+	return SudoTx(api, senderKeypair, "Ibc.set_params", gstypes.NewBool(true), gstypes.NewBool(true))
 }
 
 // SendIbcFundsTx sends funds to a wallet using the SubstrateAPI
@@ -134,6 +118,78 @@ func MintFundsTx(
 	return CreateSignSubmitExt(api, meta, senderKeypair, call)
 }
 
+// WeightV2 is the two dimensional weight used by sudo_unchecked_weight since substrate 0.9.3x
+type WeightV2 struct {
+	RefTime   gstypes.UCompact
+	ProofSize gstypes.UCompact
+}
+
+// SudoTx dispatches a call with root origin, senderKeypair must be the sudo key
+func SudoTx(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair, method string, args ...interface{}) (gstypes.Hash, error) {
+	hash := gstypes.Hash{}
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return hash, err
+	}
+
+	c, err := gstypes.NewCall(meta, method, args...)
+	if err != nil {
+		return hash, err
+	}
+
+	sc, err := gstypes.NewCall(meta, "Sudo.sudo", c)
+	if err != nil {
+		return hash, err
+	}
+
+	return CreateSignSubmitExt(api, meta, senderKeypair, sc)
+}
+
+// SetCodeTx replaces the runtime with the wasm code through sudo, senderKeypair must be the sudo key.
+// System.set_code consumes a full block of weight, so it is dispatched with sudo_unchecked_weight.
+func SetCodeTx(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair, code []byte) (gstypes.Hash, error) {
+	hash := gstypes.Hash{}
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return hash, err
+	}
+
+	c, err := gstypes.NewCall(meta, "System.set_code", gstypes.NewBytes(code))
+	if err != nil {
+		return hash, err
+	}
+
+	weight := WeightV2{RefTime: gstypes.NewUCompactFromUInt(0), ProofSize: gstypes.NewUCompactFromUInt(0)}
+	sc, err := gstypes.NewCall(meta, "Sudo.sudo_unchecked_weight", c, weight)
+	if err != nil {
+		return hash, err
+	}
+
+	return CreateSignSubmitExt(api, meta, senderKeypair, sc)
+}
+
+// AuthorizeUpgradeTx authorizes a parachain runtime upgrade to the code with codeHash through sudo,
+// senderKeypair must be the sudo key
+func AuthorizeUpgradeTx(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair, codeHash gstypes.Hash, checkVersion bool) (gstypes.Hash, error) {
+	return SudoTx(api, senderKeypair, "ParachainSystem.authorize_upgrade", codeHash, gstypes.NewBool(checkVersion))
+}
+
+// EnactAuthorizedUpgradeTx provides the code of an authorized parachain runtime upgrade, any account may submit it
+func EnactAuthorizedUpgradeTx(api *gsrpc.SubstrateAPI, senderKeypair signature.KeyringPair, code []byte) (gstypes.Hash, error) {
+	hash := gstypes.Hash{}
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return hash, err
+	}
+
+	c, err := gstypes.NewCall(meta, "ParachainSystem.enact_authorized_upgrade", gstypes.NewBytes(code))
+	if err != nil {
+		return hash, err
+	}
+
+	return CreateSignSubmitExt(api, meta, senderKeypair, c)
+}
+
 // Common tx function to create an extrinsic and sign/submit it
 func CreateSignSubmitExt(
 	api *gsrpc.SubstrateAPI,
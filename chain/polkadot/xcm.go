@@ -0,0 +1,290 @@
+package polkadot
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	gsrpc "github.com/misko9/go-substrate-rpc-client/v4"
+	"github.com/misko9/go-substrate-rpc-client/v4/scale"
+	"github.com/misko9/go-substrate-rpc-client/v4/signature"
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/misko9/go-substrate-rpc-client/v4/types/codec"
+	"go.uber.org/zap"
+)
+
+// XCM locations & assets are encoded as XCM v2 (versioned index 1), which is understood by both XCM v2
+// and v3 runtimes. Only the junctions needed to address parachains, accounts and assets are supported.
+
+// XcmJunction is a single XCM v2 junction, created with one of the Xcm*Junction functions.
+type XcmJunction struct {
+	index byte
+	value interface{}
+}
+
+// XcmParachainJunction addresses a parachain by its ID.
+func XcmParachainJunction(parachainID uint32) XcmJunction {
+	return XcmJunction{index: 0, value: gstypes.NewUCompactFromUInt(uint64(parachainID))}
+}
+
+// XcmAccountID32Junction addresses a 32 byte account on any network.
+func XcmAccountID32Junction(pubKey []byte) XcmJunction {
+	var id [32]byte
+	copy(id[:], pubKey)
+	return XcmJunction{index: 1, value: struct {
+		Network gstypes.U8 // NetworkId::Any
+		ID      [32]byte
+	}{0, id}}
+}
+
+// XcmPalletInstanceJunction addresses a pallet by its index.
+func XcmPalletInstanceJunction(index uint8) XcmJunction {
+	return XcmJunction{index: 4, value: gstypes.NewU8(index)}
+}
+
+// XcmGeneralIndexJunction addresses an item, such as an asset, by its index.
+func XcmGeneralIndexJunction(index uint64) XcmJunction {
+	return XcmJunction{index: 5, value: gstypes.NewUCompactFromUInt(index)}
+}
+
+// Encode implements scale.Encodeable.
+func (j XcmJunction) Encode(encoder scale.Encoder) error {
+	if err := encoder.PushByte(j.index); err != nil {
+		return err
+	}
+	return encoder.Encode(j.value)
+}
+
+// XcmLocation is an XCM v2 MultiLocation, relative to the chain interpreting it.
+type XcmLocation struct {
+	Parents  uint8
+	Interior []XcmJunction
+}
+
+// Encode implements scale.Encodeable.
+func (l XcmLocation) Encode(encoder scale.Encoder) error {
+	if len(l.Interior) > 8 {
+		return fmt.Errorf("xcm location has %d junctions, at most 8 are supported", len(l.Interior))
+	}
+	if err := encoder.PushByte(l.Parents); err != nil {
+		return err
+	}
+	// Junctions::Here is 0, Junctions::X1..X8 are 1..8.
+	if err := encoder.PushByte(byte(len(l.Interior))); err != nil {
+		return err
+	}
+	for _, j := range l.Interior {
+		if err := encoder.Encode(j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// XcmRelayChainAsset is the relay chain's native asset, as seen from a parachain.
+var XcmRelayChainAsset = XcmLocation{Parents: 1}
+
+// XcmNativeAsset is a chain's own native asset.
+var XcmNativeAsset = XcmLocation{Parents: 0}
+
+type versionedXcmLocation XcmLocation
+
+func (l versionedXcmLocation) Encode(encoder scale.Encoder) error {
+	if err := encoder.PushByte(1); err != nil {
+		return err
+	}
+	return encoder.Encode(XcmLocation(l))
+}
+
+// versionedXcmAsset is a single fungible asset, encoded as VersionedMultiAssets.
+type versionedXcmAsset struct {
+	ID     XcmLocation
+	Amount *big.Int
+}
+
+func (a versionedXcmAsset) Encode(encoder scale.Encoder) error {
+	// V2 assets, a vec with a single AssetId::Concrete, Fungibility::Fungible asset.
+	for _, b := range []byte{1, 1 << 2, 0} {
+		if err := encoder.PushByte(b); err != nil {
+			return err
+		}
+	}
+	if err := encoder.Encode(a.ID); err != nil {
+		return err
+	}
+	if err := encoder.PushByte(0); err != nil {
+		return err
+	}
+	return encoder.EncodeUintCompact(*a.Amount)
+}
+
+// XcmReserveTransferTx transfers amount of asset to the beneficiary on dest, with the sending chain as the asset's
+// reserve, using limited_reserve_transfer_assets of the XCM pallet (PolkadotXcm on parachains, XcmPallet on relay chains).
+func XcmReserveTransferTx(
+	api *gsrpc.SubstrateAPI,
+	senderKeypair signature.KeyringPair,
+	dest XcmLocation,
+	beneficiary XcmLocation,
+	asset XcmLocation,
+	amount math.Int,
+) (gstypes.Hash, error) {
+	hash := gstypes.Hash{}
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return hash, err
+	}
+
+	method := "PolkadotXcm.limited_reserve_transfer_assets"
+	if _, err := meta.FindCallIndex(method); err != nil {
+		method = "XcmPallet.limited_reserve_transfer_assets"
+	}
+
+	unlimited := gstypes.NewU8(0) // WeightLimit::Unlimited
+	call, err := gstypes.NewCall(meta, method,
+		versionedXcmLocation(dest),
+		versionedXcmLocation(beneficiary),
+		versionedXcmAsset{ID: asset, Amount: amount.BigInt()},
+		gstypes.NewU32(0),
+		unlimited,
+	)
+	if err != nil {
+		return hash, err
+	}
+
+	return CreateSignSubmitExt(api, meta, senderKeypair, call)
+}
+
+// GetAssetBalance fetches the balance of an account in the Assets pallet using the SubstrateAPI.
+// The asset ID is encoded as the AssetId type of the pallet, u32, u64 or u128, read from the metadata.
+func GetAssetBalance(api *gsrpc.SubstrateAPI, address string, assetID uint64) (math.Int, error) {
+	meta, err := api.RPC.State.GetMetadataLatest()
+	if err != nil {
+		return math.Int{}, err
+	}
+	pubKey, err := DecodeAddressSS58(address)
+	if err != nil {
+		return math.Int{}, err
+	}
+	id, err := encodeAssetID(meta, assetID)
+	if err != nil {
+		return math.Int{}, err
+	}
+	key, err := gstypes.CreateStorageKey(meta, "Assets", "Account", id, pubKey)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Only the leading balance of the AssetAccount is decoded.
+	var account struct {
+		Balance gstypes.U128
+	}
+	ok, err := api.RPC.State.GetStorageLatest(key, &account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if !ok {
+		return math.ZeroInt(), nil
+	}
+	return math.NewIntFromBigInt(account.Balance.Int), nil
+}
+
+// encodeAssetID encodes the asset ID as the first key of the Assets.Account storage map, the AssetId of the pallet.
+func encodeAssetID(meta *gstypes.Metadata, assetID uint64) ([]byte, error) {
+	entry, err := meta.FindStorageEntryMetadata("Assets", "Account")
+	if err != nil {
+		return nil, err
+	}
+	entryV14, ok := entry.(gstypes.StorageEntryMetadataV14)
+	if !ok || !entryV14.IsMap() {
+		return nil, fmt.Errorf("unsupported metadata of Assets.Account")
+	}
+
+	keys, ok := meta.AsMetadataV14.EfficientLookup[entryV14.Type.AsMap.Key.Int64()]
+	if !ok || !keys.Def.IsTuple || len(keys.Def.Tuple) == 0 {
+		return nil, fmt.Errorf("unsupported key of Assets.Account")
+	}
+	idType, ok := meta.AsMetadataV14.EfficientLookup[keys.Def.Tuple[0].Int64()]
+	// Follow wrappers of a single field, e.g. a newtype around the integer.
+	for ok && idType.Def.IsComposite && len(idType.Def.Composite.Fields) == 1 {
+		idType, ok = meta.AsMetadataV14.EfficientLookup[idType.Def.Composite.Fields[0].Type.Int64()]
+	}
+	if !ok || !idType.Def.IsPrimitive {
+		return nil, fmt.Errorf("unsupported AssetId type of Assets pallet")
+	}
+
+	switch idType.Def.Primitive.Si0TypeDefPrimitive {
+	case gstypes.IsU32:
+		if assetID > uint64(^uint32(0)) {
+			return nil, fmt.Errorf("asset id %d does not fit the u32 AssetId of Assets pallet", assetID)
+		}
+		return codec.Encode(gstypes.NewU32(uint32(assetID)))
+	case gstypes.IsU64:
+		return codec.Encode(gstypes.NewU64(assetID))
+	case gstypes.IsU128:
+		return codec.Encode(gstypes.NewU128(*new(big.Int).SetUint64(assetID)))
+	default:
+		return nil, fmt.Errorf("unsupported AssetId type of Assets pallet")
+	}
+}
+
+// RelayChainXcmTransfer transfers amount of the relay chain's native asset from keyName to the beneficiary
+// address on the parachain with destParachainID.
+func (c *PolkadotChain) RelayChainXcmTransfer(ctx context.Context, keyName string, destParachainID uint32, beneficiary string, amount math.Int) error {
+	return c.xcmReserveTransfer(c.RelayChainNodes[0].api, keyName,
+		XcmLocation{Parents: 0, Interior: []XcmJunction{XcmParachainJunction(destParachainID)}},
+		beneficiary, XcmNativeAsset, amount)
+}
+
+// ParachainXcmTransfer transfers amount of the asset from keyName on the parachain at parachainIndex to the
+// beneficiary address on the parachain with destParachainID, or on the relay chain if destParachainID is 0.
+// The asset's location is relative to the sending parachain, e.g. XcmNativeAsset or XcmRelayChainAsset.
+func (c *PolkadotChain) ParachainXcmTransfer(
+	ctx context.Context,
+	parachainIndex int,
+	keyName string,
+	destParachainID uint32,
+	beneficiary string,
+	asset XcmLocation,
+	amount math.Int,
+) error {
+	dest := XcmLocation{Parents: 1}
+	if destParachainID != 0 {
+		dest.Interior = []XcmJunction{XcmParachainJunction(destParachainID)}
+	}
+	return c.xcmReserveTransfer(c.ParachainNodes[parachainIndex][0].api, keyName, dest, beneficiary, asset, amount)
+}
+
+func (c *PolkadotChain) xcmReserveTransfer(
+	api *gsrpc.SubstrateAPI,
+	keyName string,
+	dest XcmLocation,
+	beneficiary string,
+	asset XcmLocation,
+	amount math.Int,
+) error {
+	kp, err := c.GetKeyringPair(keyName)
+	if err != nil {
+		return err
+	}
+	pubKey, err := DecodeAddressSS58(beneficiary)
+	if err != nil {
+		return err
+	}
+
+	hash, err := XcmReserveTransferTx(api, kp, dest,
+		XcmLocation{Parents: 0, Interior: []XcmJunction{XcmAccountID32Junction(pubKey)}},
+		asset, amount)
+	if err != nil {
+		return fmt.Errorf("failed to send xcm transfer: %w", err)
+	}
+
+	c.log.Info("XCM transfer sent", zap.String("to", beneficiary), zap.String("amount", amount.String()), zap.String("hash", fmt.Sprintf("%#x", hash)))
+	return nil
+}
+
+// GetForeignAssetBalance returns the balance of the asset with assetID, e.g. an XCM transferred asset registered
+// in the Assets pallet, of the address on the parachain at parachainIndex.
+func (c *PolkadotChain) GetForeignAssetBalance(ctx context.Context, parachainIndex int, address string, assetID uint64) (math.Int, error) {
+	return GetAssetBalance(c.ParachainNodes[parachainIndex][0].api, address, assetID)
+}
@@ -0,0 +1,80 @@
+package polkadot
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	gstypes "github.com/misko9/go-substrate-rpc-client/v4/types"
+	"github.com/misko9/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXcmEncoding(t *testing.T) {
+	dest := XcmLocation{Parents: 1, Interior: []XcmJunction{XcmParachainJunction(2000)}}
+	bz, err := codec.Encode(versionedXcmLocation(dest))
+	require.NoError(t, err)
+	// V2, parents 1, X1, Parachain(compact 2000)
+	require.Equal(t, []byte{1, 1, 1, 0, 0x41, 0x1f}, bz)
+
+	pubKey := bytes.Repeat([]byte{0xaa}, 32)
+	beneficiary := XcmLocation{Interior: []XcmJunction{XcmAccountID32Junction(pubKey)}}
+	bz, err = codec.Encode(versionedXcmLocation(beneficiary))
+	require.NoError(t, err)
+	// V2, parents 0, X1, AccountId32 { network: Any, id }
+	require.Equal(t, append([]byte{1, 0, 1, 1, 0}, pubKey...), bz)
+
+	bz, err = codec.Encode(versionedXcmAsset{ID: XcmRelayChainAsset, Amount: math.NewInt(100).BigInt()})
+	require.NoError(t, err)
+	// V2, 1 asset, Concrete, parents 1, Here, Fungible(compact 100)
+	require.Equal(t, []byte{1, 4, 0, 1, 0, 0, 0x91, 0x01}, bz)
+
+	asset := XcmLocation{Interior: []XcmJunction{XcmPalletInstanceJunction(50), XcmGeneralIndexJunction(1)}}
+	bz, err = codec.Encode(asset)
+	require.NoError(t, err)
+	// parents 0, X2, PalletInstance(50), GeneralIndex(compact 1)
+	require.Equal(t, []byte{0, 2, 4, 50, 5, 4}, bz)
+}
+
+// assetsMetadata returns metadata with an Assets.Account storage map keyed by (AssetId, AccountId).
+func assetsMetadata(assetID gstypes.Si0TypeDefPrimitive) *gstypes.Metadata {
+	lookup := map[int64]*gstypes.Si1Type{
+		0: {Def: gstypes.Si1TypeDef{IsPrimitive: true, Primitive: gstypes.Si1TypeDefPrimitive{Si0TypeDefPrimitive: assetID}}},
+		1: {Def: gstypes.Si1TypeDef{IsArray: true, Array: gstypes.Si1TypeDefArray{Len: 32}}},
+		2: {Def: gstypes.Si1TypeDef{IsTuple: true, Tuple: gstypes.Si1TypeDefTuple{
+			gstypes.NewSi1LookupTypeIDFromUInt(0),
+			gstypes.NewSi1LookupTypeIDFromUInt(1),
+		}}},
+	}
+	account := gstypes.StorageEntryMetadataV14{
+		Name: "Account",
+		Type: gstypes.StorageEntryTypeV14{IsMap: true, AsMap: gstypes.MapTypeV14{Key: gstypes.NewSi1LookupTypeIDFromUInt(2)}},
+	}
+	return &gstypes.Metadata{
+		Version: 14,
+		AsMetadataV14: gstypes.MetadataV14{
+			Pallets: []gstypes.PalletMetadataV14{{
+				Name:       "Assets",
+				HasStorage: true,
+				Storage:    gstypes.StorageMetadataV14{Prefix: "Assets", Items: []gstypes.StorageEntryMetadataV14{account}},
+			}},
+			EfficientLookup: lookup,
+		},
+	}
+}
+
+func TestEncodeAssetID(t *testing.T) {
+	bz, err := encodeAssetID(assetsMetadata(gstypes.IsU32), 7)
+	require.NoError(t, err)
+	require.Equal(t, []byte{7, 0, 0, 0}, bz)
+
+	_, err = encodeAssetID(assetsMetadata(gstypes.IsU32), 1<<32)
+	require.Error(t, err)
+
+	bz, err = encodeAssetID(assetsMetadata(gstypes.IsU128), 7)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{7}, make([]byte, 15)...), bz)
+
+	_, err = encodeAssetID(assetsMetadata(gstypes.IsStr), 7)
+	require.Error(t, err)
+}
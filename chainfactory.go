@@ -155,7 +155,7 @@ func buildChain(log *zap.Logger, testName string, cfg ibc.ChainConfig, numValida
 			return nil, fmt.Errorf("unexpected error, unknown polkadot parachain: %s", cfg.Name)
 		}
 	case "ethereum":
-		return ethereum.NewEthereumChain(testName, cfg, log), nil
+		return ethereum.NewEthereumChainWithNodes(testName, cfg, nv, nf, log), nil
 	default:
 		return nil, fmt.Errorf("unexpected error, unknown chain type: %s for chain: %s", cfg.Type, cfg.Name)
 	}
@@ -0,0 +1,91 @@
+// Command cosmwasm-codegen generates Go clients of CosmWasm contracts from their API schemas, written by
+// cosmwasm-schema with `cargo schema`.
+//
+// Generate the client of a contract:
+//
+//	cosmwasm-codegen -schema contract/schema/counter.json -out counter/client.go
+//
+// Generate the clients of all contracts of a workspace compiled with cosmwasm.Workspace, in packages named after
+// their crates:
+//
+//	cosmwasm-codegen -workspace workspace -out clients
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/strangelove-ventures/interchaintest/v8/contract/cosmwasm/codegen"
+)
+
+func main() {
+	var (
+		schemaPath    = flag.String("schema", "", "path to the API schema of a contract")
+		workspacePath = flag.String("workspace", "", "path to a workspace, to generate clients of all its contracts")
+		out           = flag.String("out", "", "file of the client with -schema, directory of the client packages with -workspace")
+		pkg           = flag.String("package", "", "package of the client with -schema (default: the crate name)")
+	)
+	flag.Parse()
+
+	if err := run(*schemaPath, *workspacePath, *out, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "cosmwasm-codegen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath, workspacePath, out, pkg string) error {
+	switch {
+	case out == "":
+		return fmt.Errorf("-out is required")
+	case (schemaPath == "") == (workspacePath == ""):
+		return fmt.Errorf("exactly one of -schema or -workspace is required")
+	case schemaPath != "":
+		return generate(schemaPath, out, pkg)
+	}
+
+	schemas, err := codegen.FindSchemas(workspacePath)
+	if err != nil {
+		return fmt.Errorf("find schemas: %w", err)
+	}
+	if len(schemas) == 0 {
+		return fmt.Errorf("no contract API schemas in %s", workspacePath)
+	}
+
+	crates := make([]string, 0, len(schemas))
+	for crate := range schemas {
+		crates = append(crates, crate)
+	}
+	sort.Strings(crates)
+	for _, crate := range crates {
+		if err := generate(schemas[crate], filepath.Join(out, crate, "client.go"), crate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func generate(schemaPath, out, pkg string) error {
+	schema, err := codegen.ReadSchema(schemaPath)
+	if err != nil {
+		return err
+	}
+	if pkg == "" {
+		pkg = schema.CrateName()
+	}
+
+	src, err := codegen.Generate(schema, pkg)
+	if err != nil {
+		return fmt.Errorf("generate client of %s: %w", schema.ContractName, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		return err
+	}
+	fmt.Println("generated", out)
+	return nil
+}
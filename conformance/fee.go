@@ -0,0 +1,149 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRelayerFee asserts that the relayer relays a packet incentivized with ICS-29 fees
+// over a fee enabled channel, and that the recv and ack fees are paid to the relayer's payee.
+// Both chains must have the fee middleware wired to the transfer port, so it is not part of Test,
+// examples/ibc runs it with ibc-go simd chains.
+func TestRelayerFee(t *testing.T, ctx context.Context, cf interchaintest.ChainFactory, rf interchaintest.RelayerFactory, rep *testreporter.Reporter) {
+	rep.TrackTest(t)
+
+	requireCapabilities(t, rep, rf, relayer.Fee)
+
+	req := require.New(rep.TestifyT(t))
+	chains, err := cf.Chains(t.Name())
+	req.NoError(err, "failed to get chains")
+
+	if len(chains) != 2 {
+		panic(fmt.Errorf("expected 2 chains, got %d", len(chains)))
+	}
+
+	c0, ok0 := chains[0].(*cosmos.CosmosChain)
+	c1, ok1 := chains[1].(*cosmos.CosmosChain)
+	if !ok0 || !ok1 {
+		rep.TrackSkip(t, "skipping fee middleware test for non cosmos chains")
+	}
+
+	client, network := interchaintest.DockerSetup(t)
+
+	r := rf.Build(t, client, network)
+
+	const pathName = "p"
+	ic := interchaintest.NewInterchain().
+		AddChain(c0).
+		AddChain(c1).
+		AddRelayer(r, "r").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  c0,
+			Chain2:  c1,
+			Relayer: r,
+
+			Path:              pathName,
+			CreateChannelOpts: ibc.DefaultFeeChannelOpts(),
+		})
+
+	eRep := rep.RelayerExecReporter(t)
+
+	req.NoError(ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	defer ic.Close()
+
+	channels, err := r.GetChannels(ctx, eRep, c0.Config().ChainID)
+	req.NoError(err)
+	req.Len(channels, 1)
+	channel := channels[0]
+
+	feeEnabled, err := c0.IbcFeeQueryFeeEnabledChannel(ctx, channel.PortID, channel.ChannelID)
+	req.NoError(err)
+	req.True(feeEnabled, "channel is not fee enabled on %s", c0.Config().ChainID)
+	feeEnabled, err = c1.IbcFeeQueryFeeEnabledChannel(ctx, channel.Counterparty.PortID, channel.Counterparty.ChannelID)
+	req.NoError(err)
+	req.True(feeEnabled, "channel is not fee enabled on %s", c1.Config().ChainID)
+
+	// Pay both the recv fee, earned on c1, and the ack fee, earned on c0, to a payee with no funds on c0.
+	payee, err := c0.BuildWallet(ctx, "fee-payee", "")
+	req.NoError(err)
+
+	const relayerKeyName = "fee-relayer"
+	c0RelayerWallet, ok := ic.RelayerWallet(r, c0)
+	req.True(ok)
+	c1RelayerWallet, ok := ic.RelayerWallet(r, c1)
+	req.True(ok)
+	req.NoError(c0.RecoverKey(ctx, relayerKeyName, c0RelayerWallet.Mnemonic()))
+	req.NoError(c1.RecoverKey(ctx, relayerKeyName, c1RelayerWallet.Mnemonic()))
+
+	req.NoError(c0.GetNode().IbcFeeRegisterPayee(ctx, relayerKeyName,
+		channel.PortID, channel.ChannelID, c0RelayerWallet.FormattedAddress(), payee.FormattedAddress(),
+	))
+	req.NoError(c1.GetNode().IbcFeeRegisterCounterpartyPayee(ctx, relayerKeyName,
+		channel.Counterparty.PortID, channel.Counterparty.ChannelID, c1RelayerWallet.FormattedAddress(), payee.FormattedAddress(),
+	))
+
+	registeredPayee, err := c0.IbcFeeQueryPayee(ctx, channel.ChannelID, c0RelayerWallet.FormattedAddress())
+	req.NoError(err)
+	req.Equal(payee.FormattedAddress(), registeredPayee)
+	counterpartyPayee, err := c1.IbcFeeQueryCounterpartyPayee(ctx, channel.Counterparty.ChannelID, c1RelayerWallet.FormattedAddress())
+	req.NoError(err)
+	req.Equal(payee.FormattedAddress(), counterpartyPayee)
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "fee", userFaucetFund, c0, c1)
+	c0User, c1User := users[0], users[1]
+
+	tx, err := c0.SendIBCTransfer(ctx, channel.ChannelID, c0User.KeyName(), ibc.WalletAmount{
+		Address: c1User.FormattedAddress(),
+		Denom:   c0.Config().Denom,
+		Amount:  testCoinAmount,
+	}, ibc.TransferOptions{})
+	req.NoError(err)
+	req.NoError(tx.Validate())
+
+	var (
+		denom      = c0.Config().Denom
+		recvFee    = math.NewInt(1000)
+		ackFee     = math.NewInt(500)
+		timeoutFee = math.NewInt(250)
+	)
+	req.NoError(c0.GetNode().IbcFeePayPacketFee(ctx, c0User.KeyName(), channel.PortID, channel.ChannelID, tx.Packet.Sequence,
+		recvFee.String()+denom, ackFee.String()+denom, timeoutFee.String()+denom,
+	))
+
+	packets, err := c0.IbcFeeQueryIncentivizedPackets(ctx, channel.PortID, channel.ChannelID)
+	req.NoError(err)
+	req.Len(packets, 1)
+	req.Equal(tx.Packet.Sequence, packets[0].PacketId.Sequence)
+
+	req.NoError(r.StartRelayer(ctx, eRep, pathName))
+	defer func() {
+		if err := r.StopRelayer(ctx, eRep); err != nil {
+			t.Logf("failed to stop relayer: %v", err)
+		}
+	}()
+
+	_, err = testutil.PollForAck(ctx, c0, tx.Height, tx.Height+pollHeightMax, tx.Packet)
+	req.NoError(err, "failed to get acknowledgement of incentivized packet")
+
+	payeeBalance, err := c0.GetBalance(ctx, payee.FormattedAddress(), denom)
+	req.NoError(err)
+	req.True(payeeBalance.Equal(recvFee.Add(ackFee)), "expected payee balance %s, got %s", recvFee.Add(ackFee), payeeBalance)
+
+	packets, err = c0.IbcFeeQueryIncentivizedPackets(ctx, channel.PortID, channel.ChannelID)
+	req.NoError(err)
+	req.Empty(packets)
+}
@@ -0,0 +1,248 @@
+package cosmwasm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+// artifactsCacheDir is the directory where artifacts of compilations are cached.
+// The directory is $HOME/.interchaintest/cosmwasm by default, or the environment variable IBCTEST_COSMWASM_CACHE.
+var artifactsCacheDir = defaultCacheDir()
+
+func defaultCacheDir() string {
+	if dir := os.Getenv("IBCTEST_COSMWASM_CACHE"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".interchaintest", "cosmwasm")
+}
+
+// SetCacheDir sets the directory where artifacts of compilations are cached, by a hash of the source tree and
+// the optimizer image and version. Compiling sources with cached artifacts reuses them instead of running the
+// optimizer, so the artifacts directory of the sources is only written when the optimizer runs. An empty dir
+// disables the cache.
+func SetCacheDir(dir string) {
+	artifactsCacheDir = dir
+}
+
+// compiles is the queue of compilations shared by all contracts and workspaces of the process.
+var compiles = newCompileQueue(2)
+
+// SetMaxConcurrentCompiles sets the number of optimizer containers run at once, 2 by default.
+// Further compilations wait in a queue.
+func SetMaxConcurrentCompiles(n int) {
+	if n < 1 {
+		n = 1
+	}
+	compiles.setLimit(n)
+}
+
+// compileQueue runs compilations, at most limit at once. Concurrent compilations of the same sources and
+// optimizer share one run of the optimizer.
+type compileQueue struct {
+	mu       sync.Mutex
+	limit    int
+	running  int
+	waiting  *sync.Cond
+	inflight map[string]*compileCall
+
+	// joined is called when a compilation joins a running one of the same key, in tests.
+	joined func(key string)
+}
+
+type compileCall struct {
+	done          chan struct{}
+	artifactsPath string
+	err           error
+}
+
+func newCompileQueue(limit int) *compileQueue {
+	q := &compileQueue{limit: limit, inflight: make(map[string]*compileCall)}
+	q.waiting = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *compileQueue) setLimit(limit int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limit = limit
+	q.waiting.Broadcast()
+}
+
+// do runs fn once for concurrent calls with the same key, when fewer than limit calls of fn are running.
+func (q *compileQueue) do(key string, fn func() (string, error)) (string, error) {
+	q.mu.Lock()
+	if call, ok := q.inflight[key]; ok {
+		q.mu.Unlock()
+		if q.joined != nil {
+			q.joined(key)
+		}
+		<-call.done
+		return call.artifactsPath, call.err
+	}
+	call := &compileCall{done: make(chan struct{})}
+	q.inflight[key] = call
+
+	for q.running >= q.limit {
+		q.waiting.Wait()
+	}
+	q.running++
+	q.mu.Unlock()
+
+	call.artifactsPath, call.err = fn()
+
+	q.mu.Lock()
+	q.running--
+	delete(q.inflight, key)
+	q.waiting.Signal()
+	q.mu.Unlock()
+	close(call.done)
+
+	return call.artifactsPath, call.err
+}
+
+// compileCached compiles the repo at the relative path, or reuses the cached artifacts of its sources.
+// Returns the path of the directory with the artifacts, i.e. checksums.txt and the wasm binaries.
+func compileCached(image string, optVersion string, repoPath string) (string, error) {
+	// Get absolute path of contract project
+	pwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("getwd: %w", err)
+	}
+	repoPathFull := filepath.Join(pwd, repoPath)
+
+	key, err := compileKey(image, optVersion, repoPathFull)
+	if err != nil {
+		return "", fmt.Errorf("hash sources: %w", err)
+	}
+
+	return compiles.do(key, func() (string, error) {
+		dir := artifactsCacheDir
+		cachedPath := filepath.Join(dir, key)
+		if dir != "" {
+			if _, err := os.Stat(filepath.Join(cachedPath, "checksums.txt")); err == nil {
+				return cachedPath, nil
+			}
+		}
+
+		if _, err := compile(image, optVersion, repoPathFull); err != nil {
+			return "", err
+		}
+
+		artifactsPath := filepath.Join(repoPathFull, "artifacts")
+		if dir == "" {
+			return artifactsPath, nil
+		}
+		if err := cacheArtifacts(artifactsPath, cachedPath); err != nil {
+			return "", fmt.Errorf("cache artifacts: %w", err)
+		}
+		return cachedPath, nil
+	})
+}
+
+// compileKey returns the hash of the optimizer image and version, and of the source tree of the repo including
+// Cargo.lock. Build outputs are excluded.
+func compileKey(image string, optVersion string, repoPathFull string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00", image, optVersion, runtime.GOARCH)
+
+	err := filepath.WalkDir(repoPathFull, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case "target", "artifacts", ".git":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(repoPathFull, path)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		fmt.Fprintf(h, "%s\x00", filepath.ToSlash(rel))
+		if _, err := io.Copy(h, f); err != nil {
+			return err
+		}
+		h.Write([]byte{0})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// cacheArtifacts copies the artifacts to the cache directory. The directory is written at once, so that
+// concurrent processes never read partial artifacts.
+func cacheArtifacts(artifactsPath string, cachedPath string) error {
+	if err := os.MkdirAll(filepath.Dir(cachedPath), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(cachedPath), filepath.Base(cachedPath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	entries, err := os.ReadDir(artifactsPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if err := copyFile(filepath.Join(artifactsPath, entry.Name()), filepath.Join(tmp, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(tmp, cachedPath); err != nil {
+		// Another process cached the same artifacts first.
+		if _, statErr := os.Stat(filepath.Join(cachedPath, "checksums.txt")); statErr == nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
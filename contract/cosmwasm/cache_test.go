@@ -0,0 +1,139 @@
+package cosmwasm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestCompileKey(t *testing.T) {
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"Cargo.toml":    "[package]",
+		"Cargo.lock":    "version = 3",
+		"src/lib.rs":    "pub mod contract;",
+		"target/a.rlib": "a",
+	})
+
+	key, err := compileKey("cosmwasm/rust-optimizer", "0.14.0", repo)
+	require.NoError(t, err)
+
+	// Build outputs are not part of the sources.
+	writeFiles(t, repo, map[string]string{"target/b.rlib": "b", "artifacts/checksums.txt": "c"})
+	sameKey, err := compileKey("cosmwasm/rust-optimizer", "0.14.0", repo)
+	require.NoError(t, err)
+	require.Equal(t, key, sameKey)
+
+	otherVersion, err := compileKey("cosmwasm/rust-optimizer", "0.13.0", repo)
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherVersion)
+
+	writeFiles(t, repo, map[string]string{"Cargo.lock": "version = 4"})
+	changedLock, err := compileKey("cosmwasm/rust-optimizer", "0.14.0", repo)
+	require.NoError(t, err)
+	require.NotEqual(t, key, changedLock)
+}
+
+func TestCacheArtifacts(t *testing.T) {
+	artifacts := t.TempDir()
+	writeFiles(t, artifacts, map[string]string{
+		"checksums.txt":  "abc  contract.wasm\n",
+		"contract.wasm":  "wasm",
+		"intermediate/x": "skipped",
+	})
+
+	cached := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, cacheArtifacts(artifacts, cached))
+	// Caching the same artifacts again, e.g. by another process, keeps the cached artifacts.
+	require.NoError(t, cacheArtifacts(artifacts, cached))
+
+	entries, err := os.ReadDir(cached)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	wasm, err := os.ReadFile(filepath.Join(cached, "contract.wasm"))
+	require.NoError(t, err)
+	require.Equal(t, "wasm", string(wasm))
+}
+
+func TestCompileQueue(t *testing.T) {
+	q := newCompileQueue(2)
+	joined := make(chan string, 1)
+	q.joined = func(key string) { joined <- key }
+
+	type result struct {
+		key, path string
+		err       error
+	}
+
+	// Compilations of the same sources share a run of the optimizer.
+	release := make(chan struct{})
+	started := make(chan struct{})
+	first := make(chan result, 1)
+	go func() {
+		path, err := q.do("a", func() (string, error) {
+			close(started)
+			<-release
+			return "artifacts", nil
+		})
+		first <- result{"a", path, err}
+	}()
+	<-started
+
+	second := make(chan result, 1)
+	go func() {
+		path, err := q.do("a", func() (string, error) {
+			return "", errors.New("compiled twice")
+		})
+		second <- result{"a", path, err}
+	}()
+	require.Equal(t, "a", <-joined)
+	close(release)
+	for _, res := range []result{<-first, <-second} {
+		require.NoError(t, res.err)
+		require.Equal(t, "artifacts", res.path)
+	}
+
+	// At most limit compilations run at once. The first compilations block until two of them run, so the
+	// queue must also run up to limit compilations at once.
+	var running, maxRunning atomic.Int32
+	full := make(chan struct{})
+	var fullOnce sync.Once
+	keys := []string{"b", "c", "d", "e", "f"}
+	results := make(chan result, len(keys))
+	for _, key := range keys {
+		go func(key string) {
+			path, err := q.do(key, func() (string, error) {
+				n := running.Add(1)
+				for max := maxRunning.Load(); n > max && !maxRunning.CompareAndSwap(max, n); max = maxRunning.Load() {
+				}
+				if n == 2 {
+					fullOnce.Do(func() { close(full) })
+				}
+				<-full
+				running.Add(-1)
+				return key, nil
+			})
+			results <- result{key, path, err}
+		}(key)
+	}
+	for range keys {
+		res := <-results
+		require.NoError(t, res.err)
+		require.Equal(t, res.key, res.path)
+	}
+	require.LessOrEqual(t, maxRunning.Load(), int32(2))
+}
@@ -0,0 +1,659 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Generate returns the Go source of a package named packageName with types of the messages and query responses
+// of the contract, and a Client executing and querying the contract on a *cosmos.CosmosChain.
+// Execute and query messages with variants get a method per variant, e.g. QueryOwner for the "owner" query.
+func Generate(schema *Schema, packageName string) ([]byte, error) {
+	g := &generator{
+		schema:        schema,
+		definitions:   make(map[string]*JSONSchema),
+		names:         make(map[string]bool),
+		methods:       make(map[string]bool),
+		responseTypes: make(map[string]string),
+	}
+	for _, name := range []string{"CrateName", "Store", "Instantiate", "Client", "NewClient"} {
+		g.names[name] = true
+	}
+	for _, name := range []string{"ContractAddress", "MigrateContract"} {
+		g.methods[name] = true
+	}
+
+	if err := g.generate(); err != nil {
+		return nil, err
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by cosmwasm-codegen from the API schema of %s %s. DO NOT EDIT.\n\n", schema.ContractName, schema.ContractVersion)
+	fmt.Fprintf(&src, "package %s\n\n", packageName)
+	src.WriteString(`import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+)
+`)
+	src.Write(g.decls.Bytes())
+	src.Write(g.client.Bytes())
+
+	bz, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated client: %w", err)
+	}
+	return bz, nil
+}
+
+type generator struct {
+	schema      *Schema
+	definitions map[string]*JSONSchema
+
+	// names are the declared identifiers of the package, methods those of the Client.
+	names   map[string]bool
+	methods map[string]bool
+	// responseTypes are the Go types of query responses by their schema, as queries may share responses.
+	responseTypes map[string]string
+
+	decls  bytes.Buffer
+	client bytes.Buffer
+}
+
+func (g *generator) generate() error {
+	if err := g.collectDefinitions(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(g.definitions))
+	for name := range g.definitions {
+		if g.names[exportedName(name)] {
+			return fmt.Errorf("definition %s conflicts with a declaration of the client", name)
+		}
+		g.names[exportedName(name)] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.namedType(exportedName(name), g.definitions[name])
+	}
+
+	instantiateType := g.rootType(g.schema.Instantiate, "InstantiateMsg")
+	migrateType := g.rootType(g.schema.Migrate, "MigrateMsg")
+
+	fmt.Fprintf(&g.client, `
+// CrateName is the name of the crate of the contract, its key in the binaries of cosmwasm.Workspace.WaitForCompile.
+const CrateName = %q
+
+// Store stores the contract from the binaries of cosmwasm.Workspace.WaitForCompile. Returns the code id.
+func Store(ctx context.Context, chain *cosmos.CosmosChain, keyName string, contractBinaries map[string]string, extraExecTxArgs ...string) (string, error) {
+	binary, ok := contractBinaries[CrateName]
+	if !ok {
+		return "", fmt.Errorf("no binary of %%s", CrateName)
+	}
+	return chain.StoreContract(ctx, keyName, binary, extraExecTxArgs...)
+}
+
+// Instantiate instantiates the contract from the code id and returns a client of it.
+func Instantiate(ctx context.Context, chain *cosmos.CosmosChain, keyName string, codeID string, msg %s, needsNoAdminFlag bool, extraExecTxArgs ...string) (*Client, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	contractAddress, err := chain.InstantiateContract(ctx, keyName, codeID, string(bz), needsNoAdminFlag, extraExecTxArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(chain, contractAddress), nil
+}
+
+// Client executes and queries a %s contract.
+type Client struct {
+	chain           *cosmos.CosmosChain
+	contractAddress string
+}
+
+// NewClient returns a client of the contract at contractAddress.
+func NewClient(chain *cosmos.CosmosChain, contractAddress string) *Client {
+	return &Client{chain: chain, contractAddress: contractAddress}
+}
+
+// ContractAddress returns the address of the contract.
+func (c *Client) ContractAddress() string {
+	return c.contractAddress
+}
+
+// MigrateContract migrates the contract to the new code id. keyName must be the admin of the contract.
+func (c *Client) MigrateContract(ctx context.Context, keyName string, newCodeID string, msg %s, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return c.chain.MigrateContract(ctx, keyName, c.contractAddress, newCodeID, string(bz), extraExecTxArgs...)
+}
+
+func (c *Client) execute(ctx context.Context, keyName string, msg any, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return c.chain.ExecuteContract(ctx, keyName, c.contractAddress, string(bz), extraExecTxArgs...)
+}
+`, g.schema.CrateName(), instantiateType, g.schema.ContractName, migrateType)
+
+	if err := g.executeMethods(); err != nil {
+		return err
+	}
+	return g.queryMethods()
+}
+
+// collectDefinitions collects the definitions of all messages and responses, which are declared once.
+func (g *generator) collectDefinitions() error {
+	roots := []*JSONSchema{g.schema.Instantiate, g.schema.Execute, g.schema.Query, g.schema.Migrate}
+	queries := make([]string, 0, len(g.schema.Responses))
+	for query := range g.schema.Responses {
+		queries = append(queries, query)
+	}
+	sort.Strings(queries)
+	for _, query := range queries {
+		roots = append(roots, g.schema.Responses[query])
+	}
+
+	for _, root := range roots {
+		if root == nil {
+			continue
+		}
+		for name, def := range root.Definitions {
+			if prev, ok := g.definitions[name]; ok {
+				prevBz, _ := json.Marshal(prev)
+				bz, _ := json.Marshal(def)
+				if !bytes.Equal(prevBz, bz) {
+					return fmt.Errorf("conflicting definitions of %s", name)
+				}
+				continue
+			}
+			g.definitions[name] = def
+		}
+	}
+	return nil
+}
+
+// rootType declares the type of a root message, e.g. InstantiateMsg. Messages without a schema are raw JSON.
+func (g *generator) rootType(s *JSONSchema, name string) string {
+	if s == nil {
+		return "json.RawMessage"
+	}
+	if s.Title != "" {
+		name = exportedName(s.Title)
+	}
+	return g.declare(s, name)
+}
+
+// variant is a variant of an enum message, serialized as {"name": msg}, or "name" for unit variants without a schema.
+type variant struct {
+	name        string
+	description string
+	schema      *JSONSchema
+}
+
+// variants returns the variants of an enum message, or false if the message is not an enum.
+func variants(s *JSONSchema) ([]variant, bool) {
+	if s == nil || len(s.OneOf) == 0 {
+		return nil, false
+	}
+
+	var vs []variant
+	for _, v := range s.OneOf {
+		if v.isType("string") && len(v.Enum) > 0 {
+			for _, value := range v.Enum {
+				var name string
+				if err := json.Unmarshal(value, &name); err != nil {
+					return nil, false
+				}
+				vs = append(vs, variant{name: name, description: v.Description})
+			}
+			continue
+		}
+		if len(v.Properties) != 1 || len(v.Required) != 1 || v.Properties[v.Required[0]] == nil {
+			return nil, false
+		}
+		vs = append(vs, variant{name: v.Required[0], description: v.Description, schema: v.Properties[v.Required[0]]})
+	}
+	return vs, true
+}
+
+func (g *generator) executeMethods() error {
+	vs, ok := variants(g.schema.Execute)
+	if !ok {
+		if g.schema.Execute == nil {
+			return nil
+		}
+		// Messages without variants are executed as is.
+		vs = []variant{{name: "execute", description: g.schema.Execute.Description, schema: g.schema.Execute}}
+	}
+
+	for _, v := range vs {
+		method := exportedName(v.name)
+		if g.methods[method] {
+			return fmt.Errorf("execute message %s conflicts with a method of the client", v.name)
+		}
+		g.methods[method] = true
+
+		g.client.WriteString("\n")
+		if v.description != "" {
+			writeComment(&g.client, v.description)
+		} else {
+			fmt.Fprintf(&g.client, "// %s executes the %s message.\n", method, v.name)
+		}
+		switch {
+		case v.schema == nil:
+			fmt.Fprintf(&g.client, `func (c *Client) %s(ctx context.Context, keyName string, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	return c.execute(ctx, keyName, %q, extraExecTxArgs...)
+}
+`, method, v.name)
+		case ok && v.schema.isEmptyObject():
+			fmt.Fprintf(&g.client, `func (c *Client) %s(ctx context.Context, keyName string, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	return c.execute(ctx, keyName, map[string]any{%q: struct{}{}}, extraExecTxArgs...)
+}
+`, method, v.name)
+		case !ok:
+			fmt.Fprintf(&g.client, `func (c *Client) Execute(ctx context.Context, keyName string, msg %s, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	return c.execute(ctx, keyName, msg, extraExecTxArgs...)
+}
+`, g.rootType(v.schema, "ExecuteMsg"))
+		default:
+			fmt.Fprintf(&g.client, `func (c *Client) %s(ctx context.Context, keyName string, msg %s, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	return c.execute(ctx, keyName, map[string]any{%q: msg}, extraExecTxArgs...)
+}
+`, method, g.goType(v.schema, method, "Msg"), v.name)
+		}
+	}
+	return nil
+}
+
+func (g *generator) queryMethods() error {
+	vs, ok := variants(g.schema.Query)
+	if !ok {
+		if g.schema.Query == nil {
+			return nil
+		}
+		// Messages without variants are queried as is, with responses of any type.
+		if g.methods["Query"] {
+			return fmt.Errorf("query message conflicts with a method of the client")
+		}
+		g.methods["Query"] = true
+		g.client.WriteString("\n")
+		writeComment(&g.client, g.schema.Query.Description)
+		fmt.Fprintf(&g.client, `func (c *Client) Query(ctx context.Context, msg %s, response any) error {
+	return c.chain.QueryContract(ctx, c.contractAddress, msg, response)
+}
+`, g.rootType(g.schema.Query, "QueryMsg"))
+		return nil
+	}
+
+	for _, v := range vs {
+		method := "Query" + exportedName(v.name)
+		if g.methods[method] {
+			return fmt.Errorf("query message %s conflicts with a method of the client", v.name)
+		}
+		g.methods[method] = true
+
+		responseType := g.responseType(v.name)
+		g.client.WriteString("\n")
+		if v.description != "" {
+			writeComment(&g.client, v.description)
+		} else {
+			fmt.Fprintf(&g.client, "// %s queries %s.\n", method, v.name)
+		}
+		switch {
+		case v.schema == nil:
+			fmt.Fprintf(&g.client, "func (c *Client) %s(ctx context.Context) (%s, error) {\n", method, responseType)
+			fmt.Fprintf(&g.client, "\tquery := %q\n", v.name)
+		case v.schema.isEmptyObject():
+			fmt.Fprintf(&g.client, "func (c *Client) %s(ctx context.Context) (%s, error) {\n", method, responseType)
+			fmt.Fprintf(&g.client, "\tquery := map[string]any{%q: struct{}{}}\n", v.name)
+		default:
+			fmt.Fprintf(&g.client, "func (c *Client) %s(ctx context.Context, msg %s) (%s, error) {\n", method, g.goType(v.schema, exportedName(v.name), "Query"), responseType)
+			fmt.Fprintf(&g.client, "\tquery := map[string]any{%q: msg}\n", v.name)
+		}
+		fmt.Fprintf(&g.client, `	var res struct {
+		Data %s `+"`json:\"data\"`"+`
+	}
+	err := c.chain.QueryContract(ctx, c.contractAddress, query, &res)
+	return res.Data, err
+}
+`, responseType)
+	}
+	return nil
+}
+
+// responseType returns the type of the response to the query, declared once for queries with the same response.
+func (g *generator) responseType(query string) string {
+	s := g.schema.Responses[query]
+	if s == nil {
+		return "json.RawMessage"
+	}
+
+	root := *s
+	root.Definitions = nil
+	bz, _ := json.Marshal(root)
+	if t, ok := g.responseTypes[string(bz)]; ok {
+		return t
+	}
+
+	name := exportedName(query) + "Response"
+	if s.Title != "" {
+		name = exportedName(s.Title)
+	}
+	t := g.goType(s, name, "Response")
+	g.responseTypes[string(bz)] = t
+	return t
+}
+
+// goType returns the Go type of values of the schema, declaring types of objects and enums named after hint.
+func (g *generator) goType(s *JSONSchema, hint string, suffixes ...string) string {
+	switch {
+	case s == nil:
+		return "json.RawMessage"
+	case s.Ref != "":
+		name, ok := strings.CutPrefix(s.Ref, "#/definitions/")
+		if !ok || g.definitions[name] == nil {
+			return "json.RawMessage"
+		}
+		return exportedName(name)
+	case len(s.AllOf) == 1:
+		return g.goType(s.AllOf[0], hint, suffixes...)
+	case len(s.AnyOf) > 0:
+		var values []*JSONSchema
+		for _, v := range s.AnyOf {
+			if !v.isType("null") {
+				values = append(values, v)
+			}
+		}
+		if len(values) != 1 {
+			return "json.RawMessage"
+		}
+		return optional(g.goType(values[0], hint, suffixes...))
+	case len(s.OneOf) > 0, len(s.Enum) > 0:
+		return g.declare(s, hint, suffixes...)
+	}
+
+	var (
+		types    []string
+		nullable bool
+	)
+	for _, typ := range s.Type {
+		if typ == "null" {
+			nullable = true
+		} else {
+			types = append(types, typ)
+		}
+	}
+	if len(types) != 1 {
+		return "json.RawMessage"
+	}
+
+	var t string
+	switch types[0] {
+	case "string":
+		t = "string"
+	case "integer":
+		t = integerType(s.Format)
+	case "number":
+		t = "float64"
+	case "boolean":
+		t = "bool"
+	case "array":
+		t = "[]json.RawMessage"
+		// Tuples have an array of items, whose values are left as raw JSON.
+		var items *JSONSchema
+		if bytes.HasPrefix(bytes.TrimSpace(s.Items), []byte("{")) && json.Unmarshal(s.Items, &items) == nil {
+			t = "[]" + g.goType(items, hint+"Item")
+		}
+	case "object":
+		t = g.objectType(s, hint, suffixes...)
+	default:
+		t = "json.RawMessage"
+	}
+	if nullable {
+		t = optional(t)
+	}
+	return t
+}
+
+func (g *generator) objectType(s *JSONSchema, hint string, suffixes ...string) string {
+	if len(s.Properties) > 0 {
+		return g.declare(s, hint, suffixes...)
+	}
+
+	ap := bytes.TrimSpace(s.AdditionalProperties)
+	switch {
+	case bytes.Equal(ap, []byte("false")):
+		// Structs without fields.
+		return g.declare(s, hint, suffixes...)
+	case bytes.HasPrefix(ap, []byte("{")):
+		var values *JSONSchema
+		if err := json.Unmarshal(ap, &values); err == nil {
+			return "map[string]" + g.goType(values, hint+"Value")
+		}
+	}
+	return "json.RawMessage"
+}
+
+// declare declares a type of the schema with an unused name, trying hint and then hint with each suffix.
+func (g *generator) declare(s *JSONSchema, hint string, suffixes ...string) string {
+	name := g.unique(hint, suffixes...)
+	g.namedType(name, s)
+	return name
+}
+
+func (g *generator) unique(name string, suffixes ...string) string {
+	candidates := []string{name}
+	for _, suffix := range suffixes {
+		candidates = append(candidates, name+suffix)
+	}
+	for _, candidate := range candidates {
+		if !g.names[candidate] {
+			g.names[candidate] = true
+			return candidate
+		}
+	}
+	for i := 2; ; i++ {
+		candidate := name + strconv.Itoa(i)
+		if !g.names[candidate] {
+			g.names[candidate] = true
+			return candidate
+		}
+	}
+}
+
+// namedType writes the declaration of the type name of the schema. Types of fields and variants are declared first.
+func (g *generator) namedType(name string, s *JSONSchema) {
+	var decl bytes.Buffer
+	decl.WriteString("\n")
+	writeComment(&decl, s.Description)
+
+	switch {
+	case len(s.OneOf) > 0:
+		g.enumType(&decl, name, s)
+	case len(s.Enum) > 0 && s.isType("string"):
+		values := make([]variant, 0, len(s.Enum))
+		for _, value := range s.Enum {
+			var v string
+			if err := json.Unmarshal(value, &v); err != nil {
+				fmt.Fprintf(&decl, "type %s = json.RawMessage\n", name)
+				g.decls.Write(decl.Bytes())
+				return
+			}
+			values = append(values, variant{name: v})
+		}
+		g.stringEnum(&decl, name, values)
+	case s.isType("object") && (len(s.Properties) > 0 || bytes.Equal(bytes.TrimSpace(s.AdditionalProperties), []byte("false"))):
+		g.structType(&decl, name, s)
+	default:
+		t := g.goType(s, name+"Value")
+		if strings.HasPrefix(t, "*") {
+			t = "json.RawMessage"
+		}
+		if t == "json.RawMessage" {
+			// Methods of json.RawMessage are needed to marshal raw JSON.
+			fmt.Fprintf(&decl, "type %s = %s\n", name, t)
+		} else {
+			fmt.Fprintf(&decl, "type %s %s\n", name, t)
+		}
+	}
+	g.decls.Write(decl.Bytes())
+}
+
+// enumType declares the type of a Rust enum, a string for unit variants, a struct with a field per variant otherwise.
+// Enums mixing both are raw JSON.
+func (g *generator) enumType(decl *bytes.Buffer, name string, s *JSONSchema) {
+	vs, ok := variants(s)
+	units := 0
+	for _, v := range vs {
+		if v.schema == nil {
+			units++
+		}
+	}
+
+	switch {
+	case ok && units == len(vs):
+		g.stringEnum(decl, name, vs)
+	case ok && units == 0:
+		var fields bytes.Buffer
+		for _, v := range vs {
+			field := exportedName(v.name)
+			writeComment(&fields, v.description)
+			fmt.Fprintf(&fields, "%s %s `json:\"%s,omitempty\"`\n", field, optional(g.goType(v.schema, name+field)), v.name)
+		}
+		fmt.Fprintf(decl, "type %s struct {\n%s}\n", name, fields.String())
+	default:
+		fmt.Fprintf(decl, "type %s = json.RawMessage\n", name)
+	}
+}
+
+func (g *generator) stringEnum(decl *bytes.Buffer, name string, values []variant) {
+	fmt.Fprintf(decl, "type %s string\n\nconst (\n", name)
+	for _, v := range values {
+		writeComment(decl, v.description)
+		fmt.Fprintf(decl, "%s %s = %q\n", g.unique(name+exportedName(v.name)), name, v.name)
+	}
+	decl.WriteString(")\n")
+}
+
+func (g *generator) structType(decl *bytes.Buffer, name string, s *JSONSchema) {
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	required := make(map[string]bool, len(s.Required))
+	for _, prop := range s.Required {
+		required[prop] = true
+	}
+
+	var fields bytes.Buffer
+	fieldNames := make(map[string]bool, len(props))
+	for _, prop := range props {
+		field := exportedName(prop)
+		for i := 2; fieldNames[field]; i++ {
+			field = exportedName(prop) + strconv.Itoa(i)
+		}
+		fieldNames[field] = true
+
+		p := s.Properties[prop]
+		t := g.goType(p, name+field)
+		tag := prop
+		if !required[prop] {
+			t = optional(t)
+			tag += ",omitempty"
+		}
+		writeComment(&fields, p.Description)
+		fmt.Fprintf(&fields, "%s %s `json:\"%s\"`\n", field, t, tag)
+	}
+	if fields.Len() == 0 {
+		fmt.Fprintf(decl, "type %s struct{}\n", name)
+		return
+	}
+	fmt.Fprintf(decl, "type %s struct {\n%s}\n", name, fields.String())
+}
+
+// isEmptyObject returns whether values of the schema are always {}, e.g. of enum variants without fields.
+func (s *JSONSchema) isEmptyObject() bool {
+	return s.Ref == "" && s.isType("object") && len(s.Properties) == 0 && bytes.Equal(bytes.TrimSpace(s.AdditionalProperties), []byte("false"))
+}
+
+func (s *JSONSchema) isType(typ string) bool {
+	for _, t := range s.Type {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// optional returns the type of optional values of type t, omitted when empty.
+func optional(t string) string {
+	if strings.HasPrefix(t, "*") || strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") || t == "json.RawMessage" {
+		return t
+	}
+	return "*" + t
+}
+
+func integerType(format string) string {
+	switch format {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		return format
+	case "uint":
+		return "uint64"
+	default:
+		return "int64"
+	}
+}
+
+// initialisms are the words capitalized as a whole in exported names.
+var initialisms = map[string]bool{
+	"API": true, "HTTP": true, "IBC": true, "ID": true, "JSON": true, "NFT": true, "URI": true, "URL": true,
+}
+
+// exportedName returns the exported Go identifier of a snake case, kebab case or camel case name.
+func exportedName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		if initialisms[strings.ToUpper(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	if b.Len() == 0 || unicode.IsDigit([]rune(b.String())[0]) {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+func writeComment(b *bytes.Buffer, description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		b.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+	}
+}
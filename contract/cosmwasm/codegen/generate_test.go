@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	schema, err := ReadSchema("testdata/token.json")
+	require.NoError(t, err)
+	require.Equal(t, "cw_token", schema.CrateName())
+
+	src, err := Generate(schema, "token")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "client.go", src, parser.AllErrors)
+	require.NoError(t, err)
+
+	for _, want := range []string{
+		"package token",
+		`const CrateName = "cw_token"`,
+		// Definitions shared by messages and responses are declared once.
+		"type Uint128 string",
+		"type Timestamp Uint64",
+		"Cap    *Uint128 `json:\"cap,omitempty\"`",
+		// Enums of unit variants are strings, other enums have a field per variant.
+		"StatusActive  Status = \"active\"",
+		"AtHeight *uint64          `json:\"at_height,omitempty\"`",
+		"Never    *ExpirationNever `json:\"never,omitempty\"`",
+		"InitialBalances []Cw20Coin      `json:\"initial_balances\"`",
+		"Labels  map[string]string   `json:\"labels,omitempty\"`",
+		"Pairs   [][]json.RawMessage `json:\"pairs,omitempty\"`",
+		"Project *string             `json:\"project,omitempty\"`",
+		"func Instantiate(ctx context.Context, chain *cosmos.CosmosChain, keyName string, codeID string, msg InstantiateMsg, needsNoAdminFlag bool, extraExecTxArgs ...string) (*Client, error) {",
+		"func (c *Client) MigrateContract(ctx context.Context, keyName string, newCodeID string, msg json.RawMessage, extraExecTxArgs ...string) (*sdk.TxResponse, error) {",
+		"// Transfer is a base message to move tokens to another account without triggering actions\nfunc (c *Client) Transfer(ctx context.Context, keyName string, msg Transfer, extraExecTxArgs ...string) (*sdk.TxResponse, error) {",
+		`return c.execute(ctx, keyName, map[string]any{"transfer": msg}, extraExecTxArgs...)`,
+		`return c.execute(ctx, keyName, "burn_all", extraExecTxArgs...)`,
+		"func (c *Client) QueryBalance(ctx context.Context, msg Balance) (BalanceResponse, error) {",
+		"func (c *Client) QueryTokenInfo(ctx context.Context) (TokenInfoResponse, error) {",
+		`query := map[string]any{"token_info": struct{}{}}`,
+		"func (c *Client) QueryMinter(ctx context.Context) (*MinterResponse, error) {",
+		"func (c *Client) QueryAllAccounts(ctx context.Context, msg AllAccounts) ([]string, error) {",
+	} {
+		require.Contains(t, string(src), want)
+	}
+}
+
+func TestGenerateConflicts(t *testing.T) {
+	schema, err := ReadSchema("testdata/token.json")
+	require.NoError(t, err)
+
+	schema.Execute.OneOf[0].Required = []string{"contract_address"}
+	schema.Execute.OneOf[0].Properties = map[string]*JSONSchema{"contract_address": schema.Execute.OneOf[0].Properties["transfer"]}
+	_, err = Generate(schema, "token")
+	require.EqualError(t, err, "execute message contract_address conflicts with a method of the client")
+}
+
+func TestFindSchemas(t *testing.T) {
+	workspace := t.TempDir()
+	token, err := os.ReadFile("testdata/token.json")
+	require.NoError(t, err)
+
+	for path, content := range map[string]string{
+		"contracts/token/schema/cw-token.json":           string(token),
+		"contracts/token/schema/raw/instantiate.json":    `{"title": "InstantiateMsg"}`,
+		"contracts/legacy/schema/execute_msg.json":       `{"title": "ExecuteMsg"}`,
+		"contracts/token/target/schema/other-token.json": string(token),
+	} {
+		path = filepath.Join(workspace, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	schemas, err := FindSchemas(workspace)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"cw_token": filepath.Join(workspace, "contracts/token/schema/cw-token.json"),
+	}, schemas)
+}
+
+func TestExportedName(t *testing.T) {
+	for name, want := range map[string]string{
+		"change_contract_owner": "ChangeContractOwner",
+		"token_id":              "TokenID",
+		"Cw20ReceiveMsg":        "Cw20ReceiveMsg",
+		"Array_of_String":       "ArrayOfString",
+		"ibc-channel":           "IBCChannel",
+		"1st":                   "X1st",
+	} {
+		require.Equal(t, want, exportedName(name))
+	}
+}
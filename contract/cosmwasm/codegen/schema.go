@@ -0,0 +1,121 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Schema is the API schema of a contract, written by the write_api! macro of cosmwasm-schema,
+// e.g. with `cargo schema` to schema/<contract_name>.json.
+type Schema struct {
+	ContractName    string                 `json:"contract_name"`
+	ContractVersion string                 `json:"contract_version"`
+	IDLVersion      string                 `json:"idl_version"`
+	Instantiate     *JSONSchema            `json:"instantiate"`
+	Execute         *JSONSchema            `json:"execute"`
+	Query           *JSONSchema            `json:"query"`
+	Migrate         *JSONSchema            `json:"migrate"`
+	Sudo            *JSONSchema            `json:"sudo"`
+	Responses       map[string]*JSONSchema `json:"responses"`
+}
+
+// CrateName returns the name of the contract's crate, which names its binary in the artifacts of the optimizers,
+// i.e. the keys of cosmwasm.Workspace.WaitForCompile.
+func (s *Schema) CrateName() string {
+	return strings.ReplaceAll(s.ContractName, "-", "_")
+}
+
+// JSONSchema is the subset of draft-07 JSON schema generated by schemars for contract messages.
+type JSONSchema struct {
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 SchemaType             `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Enum                 []json.RawMessage      `json:"enum,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties json.RawMessage        `json:"additionalProperties,omitempty"`
+	Items                json.RawMessage        `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+	AllOf                []*JSONSchema          `json:"allOf,omitempty"`
+	Definitions          map[string]*JSONSchema `json:"definitions,omitempty"`
+}
+
+// SchemaType is the type, or types, of values of a schema, e.g. ["string", "null"] for optional strings.
+type SchemaType []string
+
+func (t *SchemaType) UnmarshalJSON(bz []byte) error {
+	var typ string
+	if err := json.Unmarshal(bz, &typ); err == nil {
+		*t = SchemaType{typ}
+		return nil
+	}
+	var types []string
+	if err := json.Unmarshal(bz, &types); err != nil {
+		return err
+	}
+	*t = types
+	return nil
+}
+
+func (t SchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// ReadSchema reads the API schema of a contract.
+func ReadSchema(path string) (*Schema, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	schema := new(Schema)
+	if err := json.Unmarshal(bz, schema); err != nil {
+		return nil, fmt.Errorf("unmarshal schema %s: %w", path, err)
+	}
+	if schema.ContractName == "" {
+		return nil, fmt.Errorf("%s is not a contract API schema", path)
+	}
+	return schema, nil
+}
+
+// FindSchemas returns the paths of the API schemas of the contracts in a workspace by crate name, the keys of
+// cosmwasm.Workspace.WaitForCompile. Contracts without an API schema, e.g. only with schemas of each message
+// written by older versions of cosmwasm-schema, are skipped.
+func FindSchemas(workspacePath string) (map[string]string, error) {
+	schemas := make(map[string]string)
+	err := filepath.WalkDir(workspacePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case "target", "artifacts", ".git":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".json" || filepath.Base(filepath.Dir(path)) != "schema" {
+			return nil
+		}
+
+		schema, err := ReadSchema(path)
+		if err != nil {
+			return nil
+		}
+		schemas[schema.CrateName()] = path
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
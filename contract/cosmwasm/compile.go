@@ -18,7 +18,8 @@ import (
 )
 
 // compile will compile the specified repo using the specified docker image and version
-func compile(image string, optVersion string, repoPath string) (string, error) {
+// repoPathFull is the absolute path of the repo, the artifacts are written to its artifacts directory
+func compile(image string, optVersion string, repoPathFull string) (string, error) {
 	// Set the image to pull/use
 	arch := ""
 	if runtime.GOARCH == "arm64" {
@@ -40,13 +41,6 @@ func compile(image string, optVersion string, repoPath string) (string, error) {
 		cacheDir = "/code/target"
 	}
 
-	// Get absolute path of contract project
-	pwd, err := os.Getwd()
-	if err != nil {
-		return "", fmt.Errorf("getwd: %w", err)
-	}
-	repoPathFull := filepath.Join(pwd, repoPath)
-
 	ctx := context.Background()
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -39,20 +39,20 @@ func (c *Contract) WithVersion(version string) *Contract {
 
 // Compile will compile the contract
 //   cosmwasm/rust-optimizer is the expected docker image
+// Compilations are queued with those of other contracts, and unchanged sources reuse cached artifacts, see SetCacheDir
+// The cache is on by default, the binaries are then in the cache directory and a cache hit does not write <repo>/artifacts
 func (c *Contract) Compile() *Contract {
 	c.wasmBinPathChan = make(chan string)
 	c.errChan = make(chan error, 1)
 
 	go func() {
-		repoPathFull, err := compile(c.DockerImage, c.Version, c.RelativePath)
+		// The artifacts directory, used for checksum.txt and package.wasm, is cached unless the cache is disabled
+		artifactsPath, err := compileCached(c.DockerImage, c.Version, c.RelativePath)
 		if err != nil {
 			c.errChan <- err
 			return
 		}
 
-		// Form the path to the artifacts directory, used for checksum.txt and package.wasm
-		artifactsPath := filepath.Join(repoPathFull, "artifacts")
-
 		// Parse the checksums.txt for the crate/wasm binary name
 		checksumsPath := filepath.Join(artifactsPath, "checksums.txt")
 		checksumsBz, err := os.ReadFile(checksumsPath)
@@ -41,20 +41,20 @@ func (w *Workspace) WithVersion(version string) *Workspace {
 // Compile will compile the workspace's contracts
 //   cosmwasm/workspace-optimizer is the expected docker image
 // The workspace object is returned, call WaitForCompile() to get results
+// Compilations are queued with those of other contracts, and unchanged sources reuse cached artifacts, see SetCacheDir
+// The cache is on by default, the binaries are then in the cache directory and a cache hit does not write <repo>/artifacts
 func (w *Workspace) Compile() *Workspace {
 	w.wasmBinariesChan = make(chan map[string]string)
 	w.errChan = make(chan error, 1)
 
 	go func() {
-		repoPathFull, err := compile(w.DockerImage, w.Version, w.RelativePath)
+		// The artifacts directory, used for checksum.txt and package.wasm, is cached unless the cache is disabled
+		artifactsPath, err := compileCached(w.DockerImage, w.Version, w.RelativePath)
 		if err != nil {
 			w.errChan <- err
 			return
 		}
 
-		// Form the path to the artifacts directory, used for checksum.txt and package.wasm
-		artifactsPath := filepath.Join(repoPathFull, "artifacts")
-
 		// Parse the checksums.txt for the crate/wasm binary names
 		wasmBinaries := make(map[string]string)
 		checksumsPath := filepath.Join(artifactsPath, "checksums.txt")
@@ -0,0 +1,97 @@
+// Code generated by cosmwasm-codegen from the API schema of contract1 0.1.0. DO NOT EDIT.
+
+package contract1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+)
+
+type InstantiateMsg struct{}
+
+type MigrateMsg struct{}
+
+type ChangeContractOwner struct {
+	NewOwner string `json:"new_owner"`
+}
+
+type OwnerResponse struct {
+	Address string `json:"address"`
+}
+
+// CrateName is the name of the crate of the contract, its key in the binaries of cosmwasm.Workspace.WaitForCompile.
+const CrateName = "contract1"
+
+// Store stores the contract from the binaries of cosmwasm.Workspace.WaitForCompile. Returns the code id.
+func Store(ctx context.Context, chain *cosmos.CosmosChain, keyName string, contractBinaries map[string]string, extraExecTxArgs ...string) (string, error) {
+	binary, ok := contractBinaries[CrateName]
+	if !ok {
+		return "", fmt.Errorf("no binary of %s", CrateName)
+	}
+	return chain.StoreContract(ctx, keyName, binary, extraExecTxArgs...)
+}
+
+// Instantiate instantiates the contract from the code id and returns a client of it.
+func Instantiate(ctx context.Context, chain *cosmos.CosmosChain, keyName string, codeID string, msg InstantiateMsg, needsNoAdminFlag bool, extraExecTxArgs ...string) (*Client, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	contractAddress, err := chain.InstantiateContract(ctx, keyName, codeID, string(bz), needsNoAdminFlag, extraExecTxArgs...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(chain, contractAddress), nil
+}
+
+// Client executes and queries a contract1 contract.
+type Client struct {
+	chain           *cosmos.CosmosChain
+	contractAddress string
+}
+
+// NewClient returns a client of the contract at contractAddress.
+func NewClient(chain *cosmos.CosmosChain, contractAddress string) *Client {
+	return &Client{chain: chain, contractAddress: contractAddress}
+}
+
+// ContractAddress returns the address of the contract.
+func (c *Client) ContractAddress() string {
+	return c.contractAddress
+}
+
+// MigrateContract migrates the contract to the new code id. keyName must be the admin of the contract.
+func (c *Client) MigrateContract(ctx context.Context, keyName string, newCodeID string, msg MigrateMsg, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return c.chain.MigrateContract(ctx, keyName, c.contractAddress, newCodeID, string(bz), extraExecTxArgs...)
+}
+
+func (c *Client) execute(ctx context.Context, keyName string, msg any, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return c.chain.ExecuteContract(ctx, keyName, c.contractAddress, string(bz), extraExecTxArgs...)
+}
+
+// ChangeContractOwner executes the change_contract_owner message.
+func (c *Client) ChangeContractOwner(ctx context.Context, keyName string, msg ChangeContractOwner, extraExecTxArgs ...string) (*sdk.TxResponse, error) {
+	return c.execute(ctx, keyName, map[string]any{"change_contract_owner": msg}, extraExecTxArgs...)
+}
+
+// Owner returns the owner of the contract. Response: OwnerResponse
+func (c *Client) QueryOwner(ctx context.Context) (OwnerResponse, error) {
+	query := map[string]any{"owner": struct{}{}}
+	var res struct {
+		Data OwnerResponse `json:"data"`
+	}
+	err := c.chain.QueryContract(ctx, c.contractAddress, query, &res)
+	return res.Data, err
+}
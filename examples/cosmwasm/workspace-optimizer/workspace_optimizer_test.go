@@ -2,7 +2,6 @@ package workspace_optimizer
 
 import (
 	"context"
-	"encoding/json"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -10,6 +9,7 @@ import (
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos/wasm"
 	"github.com/strangelove-ventures/interchaintest/v8/contract/cosmwasm"
+	"github.com/strangelove-ventures/interchaintest/v8/examples/cosmwasm/workspace-optimizer/contract1"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
 	"github.com/strangelove-ventures/interchaintest/v8/testutil"
@@ -17,6 +17,8 @@ import (
 	"go.uber.org/zap/zaptest"
 )
 
+//go:generate go run ../../../cmd/cosmwasm-codegen -workspace workspace -out .
+
 // TestWorkspaceOptimizerContracts compiles a workspace's contracts using cosmwasm/workspace-optimizer
 // It then spins up a juno chain and executes tests
 func TestWorkspaceOptimizerContracts(t *testing.T) {
@@ -87,58 +89,28 @@ func TestWorkspaceOptimizerContracts(t *testing.T) {
 	contractBinaries, err := workspace.WaitForCompile()
 	require.NoError(t, err)
 
-	// Store contract
-	contractCodeId, err := juno.StoreContract(ctx, junoUser.KeyName(), contractBinaries["contract1"])
+	// Store contract, using the client generated from its API schema
+	contractCodeId, err := contract1.Store(ctx, juno, junoUser.KeyName(), contractBinaries)
 	require.NoError(t, err)
 
 	// Instantiate contract
-	contractAddr, err := juno.InstantiateContract(ctx, junoUser.KeyName(), contractCodeId, "{}", true)
+	contract, err := contract1.Instantiate(ctx, juno, junoUser.KeyName(), contractCodeId, contract1.InstantiateMsg{}, true)
 	require.NoError(t, err)
 
 	// Query current contract owner
-	var queryOwnerResp QueryOwnerResponseData
-	queryOwnerMsg := QueryMsg{Owner: &Owner{}}
-	err = juno.QueryContract(ctx, contractAddr, queryOwnerMsg, &queryOwnerResp)
+	owner, err := contract.QueryOwner(ctx)
 	require.NoError(t, err)
-	require.Equal(t, junoUser.FormattedAddress(), queryOwnerResp.Data.Address)
+	require.Equal(t, junoUser.FormattedAddress(), owner.Address)
 
 	// Set a new contract owner
 	newContractOwnerAddr := "juno1kmmr2nu0f2nha6qwhu8s6y5l6yfr3cx505jf25"
-	changeContractOwnerMsg := ExecuteMsg{
-		ChangeContractOwner: &ChangeContractOwner{
-			NewOwner: newContractOwnerAddr,
-		},
-	}
-
-	msgBz, err := json.Marshal(changeContractOwnerMsg)
-	require.NoError(t, err)
-	_, err = juno.ExecuteContract(ctx, junoUser.KeyName(), contractAddr, string(msgBz))
+	_, err = contract.ChangeContractOwner(ctx, junoUser.KeyName(), contract1.ChangeContractOwner{
+		NewOwner: newContractOwnerAddr,
+	})
 	require.NoError(t, err)
 
 	// Query the new contract owner
-	err = juno.QueryContract(ctx, contractAddr, queryOwnerMsg, &queryOwnerResp)
+	owner, err = contract.QueryOwner(ctx)
 	require.NoError(t, err)
-	require.Equal(t, newContractOwnerAddr, queryOwnerResp.Data.Address)
-}
-
-type ExecuteMsg struct {
-	ChangeContractOwner *ChangeContractOwner `json:"change_contract_owner,omitempty"`
-}
-
-type ChangeContractOwner struct {
-	NewOwner string `json:"new_owner"`
-}
-
-type QueryMsg struct {
-	Owner *Owner `json:"owner,omitempty"`
-}
-
-type Owner struct{}
-
-type QueryOwnerResponseData struct {
-	Data QueryOwnerResponse `json:"data,omitempty"`
-}
-
-type QueryOwnerResponse struct {
-	Address string `json:"address,omitempty"`
+	require.Equal(t, newContractOwnerAddr, owner.Address)
 }
@@ -0,0 +1,120 @@
+package ethereum_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/ethereum"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestGethClique(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	client, network := interchaintest.DockerSetup(t)
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	ctx := context.Background()
+
+	// Get default ethereum chain config for a geth clique network, with 3 second blocks
+	// that are final 4 blocks later
+	gethConfig := ethereum.DefaultEthereumGethChainConfig("ethereum")
+	gethConfig.ConfigFileOverrides = map[string]any{
+		ethereum.BlockTimeOverride:     3,
+		ethereum.FinalityDepthOverride: 4,
+	}
+
+	numValidators, numFullNodes := 2, 1
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{
+			ChainName:     "ethereum",
+			Name:          "ethereum",
+			Version:       "v1.13.15",
+			ChainConfig:   gethConfig,
+			NumValidators: &numValidators,
+			NumFullNodes:  &numFullNodes,
+		},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	ethereumChain := chains[0].(*ethereum.EthereumChain)
+
+	ic := interchaintest.NewInterchain().
+		AddChain(ethereumChain)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:         t.Name(),
+		Client:           client,
+		NetworkID:        network,
+		SkipPathCreation: true,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	// The dev accounts are funded at genesis, like with anvil
+	faucetAddr := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	balance, err := ethereumChain.GetBalance(ctx, faucetAddr, "")
+	require.NoError(t, err)
+	require.True(t, balance.GT(math.ZeroInt()))
+
+	ethUserInitialAmount := math.NewInt(2 * ethereum.ETHER)
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "user", ethUserInitialAmount, ethereumChain)
+	balance, err = ethereumChain.GetBalance(ctx, users[0].FormattedAddress(), "")
+	require.NoError(t, err)
+	require.True(t, ethUserInitialAmount.Equal(balance))
+
+	// Both signers seal blocks, and every node follows the chain
+	height, err := ethereumChain.Height(ctx)
+	require.NoError(t, err)
+
+	rpcClient, err := rpc.DialContext(ctx, ethereumChain.GetHostRPCAddress())
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	var signers []common.Address
+	require.NoError(t, rpcClient.CallContext(ctx, &signers, "clique_getSigners", "latest"))
+	require.Len(t, signers, numValidators)
+
+	sealers := make(map[common.Address]bool)
+	for h := height; h > 0 && h > height-2*int64(numValidators); h-- {
+		var sealer common.Address
+		require.NoError(t, rpcClient.CallContext(ctx, &sealer, "clique_getSigner", hexutil.Uint64(h)))
+		sealers[sealer] = true
+	}
+	for _, signer := range signers {
+		require.True(t, sealers[signer], "%s sealed none of the last blocks", signer)
+	}
+	for _, n := range ethereumChain.Nodes() {
+		nodeClient, err := rpc.DialContext(ctx, n.GetHostRPCAddress())
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			var nodeHeight hexutil.Uint64
+			return nodeClient.CallContext(ctx, &nodeHeight, "eth_blockNumber") == nil && int64(nodeHeight) >= height
+		}, time.Minute, time.Second, fmt.Sprintf("%s is not synced", n.Name()))
+		nodeClient.Close()
+	}
+
+	// Blocks are final after the finality depth
+	require.NoError(t, ethereumChain.WaitForFinality(ctx, height))
+	finalized, err := ethereumChain.FinalizedHeight(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, finalized, height)
+}
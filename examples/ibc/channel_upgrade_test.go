@@ -0,0 +1,123 @@
+package ibc_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestChannelUpgrade upgrades an existing transfer channel to a fee enabled channel.
+func TestChannelUpgrade(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	ctx := context.Background()
+
+	genesis := []cosmos.GenesisKV{
+		cosmos.NewGenesisKV("app_state.gov.params.voting_period", "10s"),
+		cosmos.NewGenesisKV("app_state.gov.params.min_deposit.0.denom", "photon"),
+		cosmos.NewGenesisKV("app_state.gov.params.min_deposit.0.amount", "1"),
+	}
+	chainSpec := func(name string) *interchaintest.ChainSpec {
+		return &interchaintest.ChainSpec{
+			Name:      "ibc-go-simd",
+			ChainName: name,
+			Version:   "v8.1.0",
+			ChainConfig: ibc.ChainConfig{
+				ModifyGenesis: cosmos.ModifyGenesis(genesis),
+			},
+
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		}
+	}
+
+	chains := interchaintest.CreateChainsWithChainSpecs(t, []*interchaintest.ChainSpec{chainSpec("chain1"), chainSpec("chain2")})
+	chain, counterpartyChain := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	client, network := interchaintest.DockerSetup(t)
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.Hermes, zaptest.NewLogger(t)).Build(t, client, network)
+
+	const pathName = "ibc-path"
+	ic := interchaintest.NewInterchain().
+		AddChain(chain).
+		AddChain(counterpartyChain).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chain,
+			Chain2:  counterpartyChain,
+			Relayer: r,
+			Path:    pathName,
+		})
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	user := interchaintest.GetAndFundTestUsers(t, ctx, "default", math.NewInt(10_000_000), chain)[0]
+
+	channels, err := r.GetChannels(ctx, eRep, chain.Config().ChainID)
+	require.NoError(t, err)
+	channelID := channels[0].ChannelID
+	counterpartyChannelID := channels[0].Counterparty.ChannelID
+
+	channel, err := chain.IbcChannelQuery(ctx, "transfer", channelID)
+	require.NoError(t, err)
+	feeVersion := ibc.FeeVersion(channel.Version)
+
+	height, err := chain.Height(ctx)
+	require.NoError(t, err)
+
+	fields := chantypes.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, feeVersion)
+	prop, err := chain.ChanUpgradeInit(ctx, user.KeyName(), "transfer", channelID, fields, "1photon")
+	require.NoError(t, err)
+
+	proposalID, err := strconv.ParseUint(prop.ProposalID, 10, 64)
+	require.NoError(t, err)
+	_, err = cosmos.PollForProposalStatusV1(ctx, chain, height, height+20, proposalID, govv1.StatusPassed)
+	require.NoError(t, err, "channel upgrade proposal did not pass")
+
+	upgrade, err := chain.IbcChannelQueryUpgrade(ctx, "transfer", channelID)
+	require.NoError(t, err)
+	require.Equal(t, feeVersion, upgrade.Fields.Version)
+
+	require.NoError(t, r.UpgradeChannel(ctx, eRep, pathName, channelID))
+
+	for _, end := range []struct {
+		chain     *cosmos.CosmosChain
+		channelID string
+	}{
+		{chain, channelID},
+		{counterpartyChain, counterpartyChannelID},
+	} {
+		channel, err := end.chain.IbcChannelQuery(ctx, "transfer", end.channelID)
+		require.NoError(t, err)
+		require.Equal(t, chantypes.OPEN, channel.State)
+		require.Equal(t, feeVersion, channel.Version)
+
+		feeEnabled, err := end.chain.IbcFeeQueryFeeEnabledChannel(ctx, "transfer", end.channelID)
+		require.NoError(t, err)
+		require.True(t, feeEnabled)
+	}
+}
@@ -0,0 +1,160 @@
+package ibc_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestClientRecovery lets a client expire, recovers it with a substitute client through governance,
+// then freezes it by submitting misbehaviour of the counterparty chain.
+func TestClientRecovery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	var tests = []relayerImp{
+		{
+			name:       "Cosmos Relayer",
+			relayerImp: ibc.CosmosRly,
+		},
+		{
+			name:       "Hermes",
+			relayerImp: ibc.Hermes,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testClientRecovery(t, tt.relayerImp)
+		})
+	}
+}
+
+func testClientRecovery(t *testing.T, relayerImpl ibc.RelayerImplementation) {
+	ctx := context.Background()
+
+	genesis := []cosmos.GenesisKV{
+		cosmos.NewGenesisKV("app_state.gov.params.voting_period", "10s"),
+		cosmos.NewGenesisKV("app_state.gov.params.min_deposit.0.denom", "photon"),
+		cosmos.NewGenesisKV("app_state.gov.params.min_deposit.0.amount", "1"),
+	}
+	chainSpec := func(name string) *interchaintest.ChainSpec {
+		return &interchaintest.ChainSpec{
+			Name:      "ibc-go-simd",
+			ChainName: name,
+			Version:   "v8.1.0",
+			ChainConfig: ibc.ChainConfig{
+				ModifyGenesis: cosmos.ModifyGenesis(genesis),
+			},
+
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		}
+	}
+
+	chains := interchaintest.CreateChainsWithChainSpecs(t, []*interchaintest.ChainSpec{chainSpec("chain1"), chainSpec("chain2")})
+	chain, counterpartyChain := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	client, network := interchaintest.DockerSetup(t)
+	r := interchaintest.NewBuiltinRelayerFactory(relayerImpl, zaptest.NewLogger(t)).Build(t, client, network)
+
+	const pathName = "ibc-path"
+	ic := interchaintest.NewInterchain().
+		AddChain(chain).
+		AddChain(counterpartyChain).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chain,
+			Chain2:  counterpartyChain,
+			Relayer: r,
+			Path:    pathName,
+
+			CreateClientOpts: ibc.CreateClientOptions{TrustingPeriod: "30s"},
+		})
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	user := interchaintest.GetAndFundTestUsers(t, ctx, "default", math.NewInt(10_000_000), chain)[0]
+
+	require.NoError(t, r.StartRelayer(ctx, eRep, pathName))
+	t.Cleanup(func() {
+		_ = r.StopRelayer(ctx, eRep)
+	})
+
+	clients, err := r.GetClients(ctx, eRep, chain.Config().ChainID)
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	subjectClientID := clients[0].ClientID
+
+	// Expire the client by keeping the relayer from updating it past its trusting period.
+	require.NoError(t, chain.ExpireClient(ctx, r, subjectClientID))
+
+	// rly does not report the status of clients, it is then queried from the chain.
+	clients, err = ibc.GetClientsWithStatus(ctx, r, eRep, chain)
+	require.NoError(t, err)
+	require.Equal(t, ibc.ClientStatusExpired, clients[0].Status)
+
+	_, err = ibc.GetActiveTransferChannel(ctx, r, eRep, chain, counterpartyChain)
+	require.Error(t, err, "transfer channel found over an expired client")
+
+	// Recover the client with the state of a new substitute client.
+	require.NoError(t, r.CreateClient(ctx, eRep, chain.Config().ChainID, counterpartyChain.Config().ChainID, pathName, ibc.CreateClientOptions{
+		TrustingPeriod: "1h",
+	}))
+	clients, err = r.GetClients(ctx, eRep, chain.Config().ChainID)
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+	substituteClientID := clients[1].ClientID
+	require.NotEqual(t, subjectClientID, substituteClientID)
+
+	height, err := chain.Height(ctx)
+	require.NoError(t, err)
+
+	prop, err := chain.RecoverClient(ctx, user.KeyName(), subjectClientID, substituteClientID, "1photon")
+	require.NoError(t, err)
+
+	proposalID, err := strconv.ParseUint(prop.ProposalID, 10, 64)
+	require.NoError(t, err)
+	_, err = cosmos.PollForProposalStatusV1(ctx, chain, height, height+20, proposalID, govv1.StatusPassed)
+	require.NoError(t, err, "client recovery proposal did not pass")
+
+	status, err := chain.IbcClientQueryStatus(ctx, subjectClientID)
+	require.NoError(t, err)
+	require.Equal(t, ibc.ClientStatusActive, status)
+
+	// Freeze the recovered client with conflicting headers signed by the counterparty chain's validators.
+	clientState, err := chain.IbcClientQueryState(ctx, subjectClientID)
+	require.NoError(t, err)
+
+	misbehaviour, err := counterpartyChain.Misbehaviour(ctx, clientState.LatestHeight)
+	require.NoError(t, err)
+	require.NoError(t, chain.GetNode().IbcClientSubmitMisbehaviour(ctx, user.KeyName(), subjectClientID, misbehaviour))
+
+	status, err = chain.IbcClientQueryStatus(ctx, subjectClientID)
+	require.NoError(t, err)
+	require.Equal(t, ibc.ClientStatusFrozen, status)
+}
@@ -0,0 +1,65 @@
+package ibc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/conformance"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestFeeMiddleware runs the ICS-29 fee conformance test with both relayers.
+// The ibc-go simd app wires the fee middleware to the transfer port.
+func TestFeeMiddleware(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	var tests = []relayerImp{
+		{
+			name:       "Cosmos Relayer",
+			relayerImp: ibc.CosmosRly,
+		},
+		{
+			name:       "Hermes",
+			relayerImp: ibc.Hermes,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+				{
+					Name:      "ibc-go-simd",
+					ChainName: "chain1",
+					Version:   "v8.0.0",
+
+					NumValidators: &numVals,
+					NumFullNodes:  &numFullNodes,
+				},
+				{
+					Name:      "ibc-go-simd",
+					ChainName: "chain2",
+					Version:   "v8.0.0",
+
+					NumValidators: &numVals,
+					NumFullNodes:  &numFullNodes,
+				},
+			})
+
+			rf := interchaintest.NewBuiltinRelayerFactory(tt.relayerImp, zaptest.NewLogger(t))
+
+			conformance.TestRelayerFee(t, ctx, cf, rf, testreporter.NewNopReporter())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package ibc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestInterchainAccounts registers an interchain account over an ordered channel and executes a bank send with it,
+// then reopens its channel after a packet timeout closed it.
+func TestInterchainAccounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	ctx := context.Background()
+
+	chains := interchaintest.CreateChainsWithChainSpecs(t, []*interchaintest.ChainSpec{
+		{
+			Name:          "ibc-go-simd",
+			ChainName:     "controller",
+			Version:       "v8.1.0",
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:      "ibc-go-simd",
+			ChainName: "host",
+			Version:   "v8.1.0",
+			ChainConfig: ibc.ChainConfig{
+				ModifyGenesis: cosmos.ModifyGenesis(cosmos.ICAHostAllowMessagesGenesis(sdk.MsgTypeURL(&banktypes.MsgSend{}))),
+			},
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+	})
+	controller, host := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	client, network := interchaintest.DockerSetup(t)
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.Hermes, zaptest.NewLogger(t)).Build(t, client, network)
+
+	const pathName = "ibc-path"
+	ic := interchaintest.NewInterchain().
+		AddChain(controller).
+		AddChain(host).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  controller,
+			Chain2:  host,
+			Relayer: r,
+			Path:    pathName,
+		})
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", math.NewInt(10_000_000), controller, host)
+	owner, recipient := users[0], users[1]
+
+	require.NoError(t, r.StartRelayer(ctx, eRep, pathName))
+	t.Cleanup(func() {
+		_ = r.StopRelayer(ctx, eRep)
+	})
+
+	connections, err := r.GetConnections(ctx, eRep, controller.Config().ChainID)
+	require.NoError(t, err)
+	connectionID := connections[0].ID
+
+	require.NoError(t, controller.GetNode().ICAControllerRegisterAccount(ctx, owner.KeyName(), connectionID, "", chantypes.ORDERED))
+
+	waitForChannel := func() {
+		require.NoError(t, testutil.WaitForCondition(time.Minute, time.Second, func() (bool, error) {
+			channel, err := controller.ICAQueryChannel(ctx, owner.FormattedAddress(), connectionID)
+			if err != nil {
+				return false, nil
+			}
+			return channel.State == chantypes.OPEN, nil
+		}))
+	}
+	waitForChannel()
+
+	icaAddress, err := controller.ICAQueryAccount(ctx, owner.FormattedAddress(), connectionID)
+	require.NoError(t, err)
+
+	denom := host.Config().Denom
+	require.NoError(t, host.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+		Address: icaAddress,
+		Denom:   denom,
+		Amount:  math.NewInt(1_000_000),
+	}))
+
+	send := func(timeout time.Duration) ibc.Tx {
+		tx, err := controller.ICASendTx(ctx, owner.KeyName(), connectionID, timeout, &banktypes.MsgSend{
+			FromAddress: icaAddress,
+			ToAddress:   recipient.FormattedAddress(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)),
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	tx := send(10 * time.Minute)
+	ack, err := testutil.PollForAck(ctx, controller, tx.Height, tx.Height+20, tx.Packet)
+	require.NoError(t, err)
+	results, err := controller.ICAAckResults(ack)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.IsType(t, &banktypes.MsgSendResponse{}, results[0])
+
+	balance, err := host.GetBalance(ctx, recipient.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10_001_000), balance)
+
+	// A packet timing out closes the ordered channel, until the account is registered again.
+	require.NoError(t, r.PauseRelayer(ctx))
+	tx = send(5 * time.Second)
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, host))
+	require.NoError(t, r.ResumeRelayer(ctx))
+
+	_, err = testutil.PollForTimeout(ctx, controller, tx.Height, tx.Height+30, tx.Packet)
+	require.NoError(t, err)
+	channel, err := controller.ICAQueryChannel(ctx, owner.FormattedAddress(), connectionID)
+	require.NoError(t, err)
+	require.Equal(t, chantypes.CLOSED, channel.State)
+
+	require.NoError(t, controller.GetNode().ICAControllerRegisterAccount(ctx, owner.KeyName(), connectionID, "", chantypes.ORDERED))
+	waitForChannel()
+
+	tx = send(10 * time.Minute)
+	ack, err = testutil.PollForAck(ctx, controller, tx.Height, tx.Height+20, tx.Packet)
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+}
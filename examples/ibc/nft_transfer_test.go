@@ -0,0 +1,120 @@
+package ibc_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestNFTTransfer sends a token over an ICS-721 channel between two irishub chains, which have the irismod nft
+// and nft-transfer modules, then sends it back to its original class.
+func TestNFTTransfer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	t.Parallel()
+
+	ctx := context.Background()
+
+	chainSpec := func(name string) *interchaintest.ChainSpec {
+		return &interchaintest.ChainSpec{
+			Name:          "irisnet",
+			ChainName:     name,
+			Version:       "v2.0.0",
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		}
+	}
+
+	chains := interchaintest.CreateChainsWithChainSpecs(t, []*interchaintest.ChainSpec{chainSpec("iris1"), chainSpec("iris2")})
+	chain, counterpartyChain := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	client, network := interchaintest.DockerSetup(t)
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.Hermes, zaptest.NewLogger(t)).Build(t, client, network)
+
+	const pathName = "nft-path"
+	ic := interchaintest.NewInterchain().
+		AddChain(chain).
+		AddChain(counterpartyChain).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chain,
+			Chain2:  counterpartyChain,
+			Relayer: r,
+			Path:    pathName,
+
+			CreateChannelOpts: ibc.DefaultNFTTransferChannelOpts(),
+		})
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", math.NewInt(10_000_000), chain, counterpartyChain)
+	sender, receiver := users[0], users[1]
+
+	require.NoError(t, r.StartRelayer(ctx, eRep, pathName))
+	t.Cleanup(func() {
+		_ = r.StopRelayer(ctx, eRep)
+	})
+
+	channels, err := r.GetChannels(ctx, eRep, chain.Config().ChainID)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	channel := channels[0]
+	require.Equal(t, ibc.NFTTransferPortID, channel.PortID)
+
+	const classID, nftID = "cats", "tabby"
+	require.NoError(t, chain.GetNode().NftIssueClass(ctx, sender.KeyName(), classID, "Cats"))
+	require.NoError(t, chain.GetNode().NftMint(ctx, sender.KeyName(), classID, nftID, "https://example.com/tabby", sender.FormattedAddress()))
+
+	tx, err := chain.SendNFTTransfer(ctx, channel.ChannelID, sender.KeyName(), receiver.FormattedAddress(), classID, nftID)
+	require.NoError(t, err)
+	_, err = testutil.PollForAck(ctx, chain, tx.Height, tx.Height+20, tx.Packet)
+	require.NoError(t, err)
+
+	// The token is received in the class of its trace on the counterparty.
+	classTrace := ibc.NFTClassTrace(classID, channel)
+	require.Equal(t, "nft-transfer/"+channel.Counterparty.ChannelID+"/"+classID, classTrace)
+	counterpartyClassID := ibc.NFTClassID(classTrace)
+
+	class, err := counterpartyChain.NftQueryClass(ctx, counterpartyClassID)
+	require.NoError(t, err)
+	require.Equal(t, counterpartyClassID, class.Id)
+
+	owner, err := counterpartyChain.NftQueryOwner(ctx, counterpartyClassID, nftID)
+	require.NoError(t, err)
+	require.Equal(t, receiver.FormattedAddress(), owner)
+
+	// Sending the token back removes the channel from its trace.
+	counterpartyChannels, err := r.GetChannels(ctx, eRep, counterpartyChain.Config().ChainID)
+	require.NoError(t, err)
+	require.Len(t, counterpartyChannels, 1)
+	require.Equal(t, classID, ibc.NFTClassTrace(classTrace, counterpartyChannels[0]))
+
+	tx, err = counterpartyChain.SendNFTTransfer(ctx, counterpartyChannels[0].ChannelID, receiver.KeyName(), sender.FormattedAddress(), counterpartyClassID, nftID)
+	require.NoError(t, err)
+	_, err = testutil.PollForAck(ctx, counterpartyChain, tx.Height, tx.Height+20, tx.Packet)
+	require.NoError(t, err)
+
+	owner, err = chain.NftQueryOwner(ctx, classID, nftID)
+	require.NoError(t, err)
+	require.Equal(t, sender.FormattedAddress(), owner)
+}
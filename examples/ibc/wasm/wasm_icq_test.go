@@ -2,15 +2,12 @@ package wasm
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"strconv"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/strangelove-ventures/interchaintest/v8"
 	cosmosChain "github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos/wasm"
@@ -54,16 +51,6 @@ func TestInterchainQueriesWASM(t *testing.T) {
 		UidGid:     dockerutil.GetHeighlinerUserString(),
 	}
 
-	genesisAllowICQ := map[string]interface{}{
-		"interchainquery": map[string]interface{}{
-			"host_port": "icqhost",
-			"params": map[string]interface{}{
-				"host_enabled":  true,
-				"allow_queries": []interface{}{"/cosmos.bank.v1beta1.Query/AllBalances"},
-			},
-		},
-	}
-
 	minVal := 1
 	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
 		{
@@ -81,7 +68,7 @@ func TestInterchainQueriesWASM(t *testing.T) {
 				TrustingPeriod: "300h",
 				GasAdjustment:  1.1,
 				EncodingConfig: wasm.WasmEncoding(),
-				ModifyGenesis:  modifyGenesisAtPath(genesisAllowICQ, "app_state"),
+				ModifyGenesis:  cosmosChain.ModifyGenesis(cosmosChain.ICQHostGenesis("/cosmos.bank.v1beta1.Query/AllBalances")),
 			}},
 		{
 			ChainName:     "receiver",
@@ -98,7 +85,7 @@ func TestInterchainQueriesWASM(t *testing.T) {
 				TrustingPeriod: "300h",
 				GasAdjustment:  1.1,
 				EncodingConfig: wasm.WasmEncoding(),
-				ModifyGenesis:  modifyGenesisAtPath(genesisAllowICQ, "app_state"),
+				ModifyGenesis:  cosmosChain.ModifyGenesis(cosmosChain.ICQHostGenesis("/cosmos.bank.v1beta1.Query/AllBalances")),
 			}},
 	})
 
@@ -184,28 +171,17 @@ func TestInterchainQueriesWASM(t *testing.T) {
 	require.NoError(t, err)
 
 	icqWasmPortId := "wasm." + contractAddr
-	destPort := "icqhost"
 	// Create channel between icq wasm contract <> icq module.
-	err = r.CreateChannel(ctx, eRep, pathName, ibc.CreateChannelOptions{
-		SourcePortName: icqWasmPortId,
-		DestPortName:   destPort,
-		Order:          ibc.Unordered,
-		Version:        "icq-1",
-	})
+	err = r.CreateChannel(ctx, eRep, pathName, cosmosChain.ICQChannelOpts(icqWasmPortId))
 	require.NoError(t, err)
 	err = testutil.WaitForBlocks(ctx, 5, chain1, chain2)
 	require.NoError(t, err)
 
 	// Query for the recently created channel-id.
-	chain2Channels, err := r.GetChannels(ctx, eRep, chain2.Config().ChainID)
+	chain1Channels, err := r.GetChannels(ctx, eRep, chain1.Config().ChainID)
 	require.NoError(t, err)
 
-	for _, c1 := range chain2Channels {
-		logger.Info("Channel", zap.String("Info", fmt.Sprintf("Channel ID: %s, Port ID: %s, Version: %s, Counterparty Channel ID: %s, Counterparty Port ID: %s", c1.ChannelID, c1.PortID, c1.Version, c1.Counterparty.ChannelID, c1.Counterparty.PortID)))
-	}
-
-	require.NoError(t, err)
-	channel := FirstWithPort(chain2Channels, destPort)
+	channel := FirstWithPort(chain1Channels, icqWasmPortId)
 	require.NotNil(t, channel)
 	require.NotEmpty(t, channel.Counterparty.ChannelID)
 
@@ -228,93 +204,24 @@ func TestInterchainQueriesWASM(t *testing.T) {
 	logger.Info("channel", zap.String("info", fmt.Sprintf("Channel Port: %s, Channel ID: %s, Counterparty Channel ID: %s", channel.PortID, channel.ChannelID, channel.Counterparty.ChannelID)))
 
 	//Query for the balances of an account on the counterparty chain using interchain queries.
-	//Get the base64 encoded chain2 user address in the format required by the AllBalances query
-	chain2UserAddrQuery := fmt.Sprintf(`{"address":"%s"}`, chain2UserAddress)
-	chain2UserAddrQueryB64 := base64.StdEncoding.EncodeToString([]byte(chain2UserAddrQuery))
-
-	// Get current block height for chain 2
-	cmd := []string{chain2.Config().Bin, "status",
-		"--node", chain2.GetRPCAddress(),
-		"--home", chain2.HomeDir(),
-	}
-	stdout, _, err := chain2.Exec(ctx, cmd, nil)
-	require.NoError(t, err)
-	blockHeightC2 := &statusResults{}
-	err = json.Unmarshal(stdout, blockHeightC2)
-	require.NoError(t, err)
-
-	//and chain 1
-	// Get current block height
-	cmd = []string{chain1.Config().Bin, "status",
-		"--node", chain1.GetRPCAddress(),
-		"--home", chain1.HomeDir(),
-	}
-	stdout, _, err = chain1.Exec(ctx, cmd, nil)
-	require.NoError(t, err)
-	blockHeightC1 := &statusResults{}
-	err = json.Unmarshal(stdout, blockHeightC1)
+	//The contract makes an interchain query to chain 2 to get the chain 2 user's balance.
+	req, err := cosmosChain.NewICQRequest("/cosmos.bank.v1beta1.Query/AllBalances", &banktypes.QueryAllBalancesRequest{
+		Address: chain2UserAddress,
+	})
 	require.NoError(t, err)
-
-	logger.Info("Chain height", zap.String("Chain 1", blockHeightC1.SyncInfo.Height), zap.String("Chain 2", blockHeightC2.SyncInfo.Height))
-
-	query := executeQuery{
-		Query: msgQuery{
-			Timeout: 1000,
-			Channel: channel.ChannelID,
-			Requests: []RequestQuery{ //can't use abci.RequestQuery since Height/Prove JSON fields are omitted which causes contract errors
-				{
-					Height: 0,
-					Prove:  false,
-					Path:   "/cosmos.bank.v1beta1.Query/AllBalances",
-					Data:   []byte(chain2UserAddrQueryB64),
-				},
-			},
-		},
-	}
-
-	b, err := json.Marshal(query)
+	tx, err := chain1CChain.ICQSendQuery(ctx, chain1User.KeyName(), contractAddr, channel.ChannelID, 1000, req)
 	require.NoError(t, err)
-	msg := string(b)
-	logger.Info("Executing msg ->", zap.String("msg", msg))
 
-	//Query the contract on chain 1. The contract makes an interchain query to chain 2 to get the chain 2 user's balance.
-	resp, err := chain1CChain.ExecuteContract(ctx, chain1User.KeyName(), contractAddr, msg)
+	ack, err := testutil.PollForAck(ctx, chain1, tx.Height, tx.Height+20, tx.Packet)
 	require.NoError(t, err)
-	require.NotNil(t, resp)
-
-	// Wait a few blocks for query to be sent to counterparty.
-	err = testutil.WaitForBlocks(ctx, 5, chain1, chain2)
+	responses, err := cosmosChain.ICQAckResponses(ack)
 	require.NoError(t, err)
+	require.Len(t, responses, 1)
 
-	// Check the results from the interchain query above.
-	cmd = []string{chain1.Config().Bin, "query", "wasm", "contract-state", "all", contractAddr,
-		"--node", chain1.GetRPCAddress(),
-		"--home", chain1.HomeDir(),
-		"--chain-id", chain1.Config().ChainID,
-		"--output", "json",
-	}
+	var balances banktypes.QueryAllBalancesResponse
+	require.NoError(t, balances.Unmarshal(responses[0].Value))
+	require.True(t, balances.Balances.AmountOf(chain2.Config().Denom).Equal(chain2UserAmt))
 
-	stdout, _, err = chain1.Exec(ctx, cmd, nil)
-	require.NoError(t, err)
-	results := &contractStateResp{}
-	err = json.Unmarshal(stdout, results)
-	require.NoError(t, err)
-	hasIcqQuery := false
-
-	for _, kv := range results.Models {
-		keyBytes, _ := hex.DecodeString(kv.Key)
-		valueBytes, err := base64.StdEncoding.DecodeString(kv.Value)
-		require.NoError(t, err)
-		if string(keyBytes) == "query_result_counter" {
-			res, err := strconv.Atoi(string(valueBytes))
-			require.NoError(t, err)
-			if res > 0 {
-				hasIcqQuery = true
-				logger.Info("ICQ query result counter", zap.Int("counter", res))
-			}
-		}
-	}
-	require.Equal(t, hasIcqQuery, true)
 }
 
 func FirstWithPort(channels []ibc.ChannelOutput, port string) *ibc.ChannelOutput {
@@ -325,72 +232,3 @@ func FirstWithPort(channels []ibc.ChannelOutput, port string) *ibc.ChannelOutput
 	}
 	return nil
 }
-
-type RequestQuery struct {
-	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height"` //do NOT 'omitempty' for JSON field or contract queries will error
-	Prove  bool   `protobuf:"varint,4,opt,name=prove,proto3" json:"prove"`   //do NOT 'omitempty' for JSON field or contract queries will error
-}
-
-type msgQuery struct {
-	Channel  string         `json:"channel"`
-	Requests []RequestQuery `json:"requests"`
-	Timeout  uint64         `json:"timeout"`
-}
-
-type executeQuery struct {
-	Query msgQuery `json:"query"`
-}
-
-type kvPair struct {
-	Key   string // hex encoded string
-	Value string // b64 encoded json
-}
-
-type contractStateResp struct {
-	Models []kvPair
-}
-
-type statusResults struct {
-	SyncInfo struct {
-		Height string `json:"latest_block_height"`
-	} `json:"SyncInfo"`
-}
-
-func modifyGenesisAtPath(insertedBlock map[string]interface{}, key string) func(ibc.ChainConfig, []byte) ([]byte, error) {
-	return func(chainConfig ibc.ChainConfig, genbz []byte) ([]byte, error) {
-		g := make(map[string]interface{})
-		if err := json.Unmarshal(genbz, &g); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal genesis file: %w", err)
-		}
-
-		//Get the section of the genesis file under the given key (e.g. "app_state")
-		genesisBlockI, ok := g[key]
-		if !ok {
-			return nil, fmt.Errorf("genesis json does not have top level key: %s", key)
-		}
-
-		blockBytes, mErr := json.Marshal(genesisBlockI)
-		if mErr != nil {
-			return nil, fmt.Errorf("genesis json marshal error for block with key: %s", key)
-		}
-
-		genesisBlock := make(map[string]interface{})
-		mErr = json.Unmarshal(blockBytes, &genesisBlock)
-		if mErr != nil {
-			return nil, fmt.Errorf("genesis json unmarshal error for block with key: %s", key)
-		}
-
-		for k, v := range insertedBlock {
-			genesisBlock[k] = v
-		}
-
-		g[key] = genesisBlock
-		out, err := json.Marshal(g)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal genesis bytes to json: %w", err)
-		}
-		return out, nil
-	}
-}
@@ -0,0 +1,94 @@
+package ibc
+
+import (
+	"encoding/json"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// ForwardIntermediateReceiver is the receiver of transfers to intermediate chains of a multi-hop transfer.
+// The packet forward middleware receives the tokens with an address it derives from the channel instead.
+const ForwardIntermediateReceiver = "pfm"
+
+// Hop is a transfer forwarded by the packet forward middleware from a chain over ChannelID.
+type Hop struct {
+	// Receiver on the chain at the other end of the channel. Defaults to ForwardIntermediateReceiver.
+	Receiver string
+	// PortID defaults to the transfer port.
+	PortID    string
+	ChannelID string
+	// Timeout of the forwarded packet. Zero uses the middleware's default.
+	Timeout time.Duration
+	// Retries of the forwarded packet on timeout. Nil uses the middleware's default.
+	Retries *uint8
+}
+
+// PacketMetadata is the memo of a transfer forwarded by the packet forward middleware.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata instructs the packet forward middleware where to forward a received transfer.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Retries  *uint8          `json:"retries,omitempty"`
+	Next     *PacketMetadata `json:"next,omitempty"`
+}
+
+// NewPacketMetadata returns the metadata forwarding a transfer through hops, in order.
+// The first hop is taken by the chain receiving the transfer. Returns nil without hops.
+func NewPacketMetadata(hops ...Hop) *PacketMetadata {
+	var next *PacketMetadata
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+		forward := &ForwardMetadata{
+			Receiver: hop.Receiver,
+			Port:     hop.PortID,
+			Channel:  hop.ChannelID,
+			Retries:  hop.Retries,
+			Next:     next,
+		}
+		if forward.Receiver == "" {
+			forward.Receiver = ForwardIntermediateReceiver
+		}
+		if forward.Port == "" {
+			forward.Port = transfertypes.PortID
+		}
+		if hop.Timeout > 0 {
+			forward.Timeout = hop.Timeout.String()
+		}
+		next = &PacketMetadata{Forward: forward}
+	}
+	return next
+}
+
+// Memo returns the metadata as the memo of a transfer, see TransferOptions.
+func (m *PacketMetadata) Memo() (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// TransferDenomTrace returns the denom trace of denom after transferring it over channels, in order.
+// Each channel is the end on the sending chain. Transferring a token back over the channel it was received on
+// removes the channel from its trace, as ICS-20 does.
+func TransferDenomTrace(denom string, channels ...ChannelOutput) transfertypes.DenomTrace {
+	path := transfertypes.ParseDenomTrace(denom).GetFullDenomPath()
+	for _, channel := range channels {
+		if transfertypes.SenderChainIsSource(channel.PortID, channel.ChannelID, path) {
+			path = transfertypes.GetPrefixedDenom(channel.Counterparty.PortID, channel.Counterparty.ChannelID, path)
+		} else {
+			path = path[len(transfertypes.GetDenomPrefix(channel.PortID, channel.ChannelID)):]
+		}
+	}
+	return transfertypes.ParseDenomTrace(path)
+}
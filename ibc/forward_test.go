@@ -0,0 +1,61 @@
+package ibc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketMetadata_Memo(t *testing.T) {
+	retries := uint8(2)
+	memo, err := NewPacketMetadata(
+		Hop{ChannelID: "channel-1", Timeout: 10 * time.Minute, Retries: &retries},
+		Hop{Receiver: "cosmos1receiver", PortID: "transfer", ChannelID: "channel-2"},
+	).Memo()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"forward": {
+			"receiver": "pfm",
+			"port": "transfer",
+			"channel": "channel-1",
+			"timeout": "10m0s",
+			"retries": 2,
+			"next": {
+				"forward": {
+					"receiver": "cosmos1receiver",
+					"port": "transfer",
+					"channel": "channel-2"
+				}
+			}
+		}
+	}`, memo)
+
+	memo, err = NewPacketMetadata().Memo()
+	require.NoError(t, err)
+	require.Empty(t, memo)
+}
+
+func TestTransferDenomTrace(t *testing.T) {
+	channel := func(channelID, counterpartyChannelID string) ChannelOutput {
+		return ChannelOutput{
+			PortID:    "transfer",
+			ChannelID: channelID,
+			Counterparty: ChannelCounterparty{
+				PortID:    "transfer",
+				ChannelID: counterpartyChannelID,
+			},
+		}
+	}
+	// A -> B over channel-0/channel-1, B -> C over channel-2/channel-3.
+	ab, ba := channel("channel-0", "channel-1"), channel("channel-1", "channel-0")
+	bc, cb := channel("channel-2", "channel-3"), channel("channel-3", "channel-2")
+
+	trace := TransferDenomTrace("uatom", ab, bc)
+	require.Equal(t, "transfer/channel-3/transfer/channel-1/uatom", trace.GetFullDenomPath())
+	require.Equal(t, "uatom", trace.GetBaseDenom())
+
+	require.Equal(t, "transfer/channel-1/uatom", TransferDenomTrace("uatom", ab, bc, cb).GetFullDenomPath())
+	require.Equal(t, "uatom", TransferDenomTrace("uatom", ab, bc, cb, ba).GetFullDenomPath())
+	require.Equal(t, "uatom", TransferDenomTrace("uatom").IBCDenom())
+}
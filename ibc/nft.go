@@ -0,0 +1,40 @@
+package ibc
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+const (
+	// NFTTransferPortID is the port of the ICS-721 nft-transfer module.
+	NFTTransferPortID = "nft-transfer"
+	// NFTTransferVersion is the version of ICS-721 channels.
+	NFTTransferVersion = "ics721-1"
+)
+
+// NFTClassTrace returns the class trace of classID after transferring it over channels, in order, e.g.
+// "nft-transfer/channel-1/cats". Each channel is the end on the sending chain. Transferring a token back over the
+// channel it was received on removes the channel from its trace, as ICS-721 does.
+func NFTClassTrace(classID string, channels ...ChannelOutput) string {
+	trace := classID
+	for _, channel := range channels {
+		prefix := fmt.Sprintf("%s/%s/", channel.PortID, channel.ChannelID)
+		if strings.HasPrefix(trace, prefix) {
+			trace = trace[len(prefix):]
+		} else {
+			trace = fmt.Sprintf("%s/%s/%s", channel.Counterparty.PortID, channel.Counterparty.ChannelID, trace)
+		}
+	}
+	return trace
+}
+
+// NFTClassID returns the class ID of the class trace on the chain holding it, "ibc/{hash}" for classes received
+// over IBC by the nft-transfer module.
+func NFTClassID(classTrace string) string {
+	if !strings.Contains(classTrace, "/") {
+		return classTrace
+	}
+	hash := sha256.Sum256([]byte(classTrace))
+	return fmt.Sprintf("ibc/%X", hash)
+}
@@ -0,0 +1,28 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNFTClassTrace(t *testing.T) {
+	channel := func(channelID, counterpartyChannelID string) ChannelOutput {
+		return ChannelOutput{
+			PortID:    NFTTransferPortID,
+			ChannelID: channelID,
+			Counterparty: ChannelCounterparty{
+				PortID:    NFTTransferPortID,
+				ChannelID: counterpartyChannelID,
+			},
+		}
+	}
+	ab, ba := channel("channel-0", "channel-1"), channel("channel-1", "channel-0")
+
+	trace := NFTClassTrace("cats", ab)
+	require.Equal(t, "nft-transfer/channel-1/cats", trace)
+	require.Equal(t, "ibc/D89B9D364809C67254084D68ACBB6E447A358FC1595580465E2B49960A5674AB", NFTClassID(trace))
+
+	require.Equal(t, "cats", NFTClassTrace("cats", ab, ba))
+	require.Equal(t, "cats", NFTClassID("cats"))
+}
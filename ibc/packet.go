@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"go.uber.org/multierr"
 )
@@ -76,6 +77,16 @@ func (ack PacketAcknowledgement) Validate() error {
 	return multierr.Append(err, ack.Packet.Validate())
 }
 
+// Success returns true if the acknowledgement is a standard ICS-4 result acknowledgement,
+// false if it is an error acknowledgement or not a standard acknowledgement.
+func (ack PacketAcknowledgement) Success() bool {
+	var res chantypes.Acknowledgement
+	if err := chantypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement, &res); err != nil {
+		return false
+	}
+	return res.Success()
+}
+
 // PacketTimeout signals a packet was not processed by the counterparty chain.
 // Indicates the sending chain should undo or rollback state.
 // Timeout conditions are block height and timestamp.
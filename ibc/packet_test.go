@@ -130,6 +130,20 @@ func TestPacketAcknowledgment_Validate(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPacketAcknowledgement_Success(t *testing.T) {
+	for _, tt := range []struct {
+		Ack     string
+		Success bool
+	}{
+		{`{"result":"AQ=="}`, true},
+		{`{"error":"ABCI code: 1: error handling packet: see events for details"}`, false},
+		{`ack`, false},
+	} {
+		ack := PacketAcknowledgement{Acknowledgement: []byte(tt.Ack)}
+		require.Equal(t, tt.Success, ack.Success(), tt.Ack)
+	}
+}
+
 func TestPacketTimeout_Validate(t *testing.T) {
 	var timeout PacketTimeout
 	require.Error(t, timeout.Validate())
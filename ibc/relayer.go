@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ptypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -73,6 +74,10 @@ type Relayer interface {
 	// Flush flushes any outstanding packets and then returns.
 	Flush(ctx context.Context, rep RelayerExecReporter, pathName string, channelID string) error
 
+	// UpgradeChannel completes the handshake of a channel upgrade which was initialized,
+	// e.g. through governance, on channelID of the path's source chain.
+	UpgradeChannel(ctx context.Context, rep RelayerExecReporter, pathName string, channelID string) error
+
 	// CreateClients performs the client handshake steps necessary for creating a light client
 	// on src that tracks the state of dst, and a light client on dst that tracks the state of src.
 	CreateClients(ctx context.Context, rep RelayerExecReporter, pathName string, opts CreateClientOptions) error
@@ -110,21 +115,66 @@ type Relayer interface {
 	SetClientContractHash(ctx context.Context, rep RelayerExecReporter, cfg ChainConfig, hash string) error
 }
 
+// ClientStatusQuerier queries the status of the light clients hosted on a chain, e.g. cosmos.CosmosChain.
+type ClientStatusQuerier interface {
+	IbcClientQueryStatus(ctx context.Context, clientID string) (string, error)
+}
+
+// GetClientsWithStatus returns the clients hosted on the chain. The status of the clients the relayer does not
+// report, e.g. with rly, is queried from the chain when it implements ClientStatusQuerier.
+func GetClientsWithStatus(ctx context.Context, r Relayer, rep RelayerExecReporter, chain Chain) (ClientOutputs, error) {
+	clients, err := r.GetClients(ctx, rep, chain.Config().ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	q, ok := chain.(ClientStatusQuerier)
+	if !ok {
+		return clients, nil
+	}
+	for _, client := range clients {
+		if client.Status != "" {
+			continue
+		}
+		if client.Status, err = q.IbcClientQueryStatus(ctx, client.ClientID); err != nil {
+			return nil, fmt.Errorf("failed to query status of client %s: %w", client.ClientID, err)
+		}
+	}
+	return clients, nil
+}
+
 // GetTransferChannel will return the transfer channel assuming only one client,
 // one connection, and one channel with "transfer" port exists between two chains.
+// Only the clients the relayer reports as expired or frozen are skipped, use GetActiveTransferChannel
+// to query their status from the chain.
 func GetTransferChannel(ctx context.Context, r Relayer, rep RelayerExecReporter, srcChainID, dstChainID string) (*ChannelOutput, error) {
 	srcClients, err := r.GetClients(ctx, rep, srcChainID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get clients on source chain: %w", err)
 	}
+	return getTransferChannel(ctx, r, rep, srcClients, srcChainID, dstChainID)
+}
 
+// GetActiveTransferChannel is GetTransferChannel skipping the expired or frozen clients of the source chain,
+// whether the relayer reports their status or not. See GetClientsWithStatus.
+func GetActiveTransferChannel(ctx context.Context, r Relayer, rep RelayerExecReporter, src, dst Chain) (*ChannelOutput, error) {
+	srcClients, err := GetClientsWithStatus(ctx, r, rep, src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clients on source chain: %w", err)
+	}
+	return getTransferChannel(ctx, r, rep, srcClients, src.Config().ChainID, dst.Config().ChainID)
+}
+
+func getTransferChannel(ctx context.Context, r Relayer, rep RelayerExecReporter, srcClients ClientOutputs, srcChainID, dstChainID string) (*ChannelOutput, error) {
 	if len(srcClients) == 0 {
-		return nil, fmt.Errorf("no clients exist on source chain: %w", err)
+		return nil, fmt.Errorf("no clients exist on source chain %s", srcChainID)
 	}
 
 	var srcClientID string
 	for _, client := range srcClients {
-		// TODO continue for expired clients
+		if !client.IsActive() {
+			continue
+		}
 		if client.ClientState.ChainID == dstChainID {
 			if srcClientID != "" {
 				return nil, fmt.Errorf("found multiple clients on %s tracking %s", srcChainID, dstChainID)
@@ -228,6 +278,48 @@ func DefaultChannelOpts() CreateChannelOptions {
 	}
 }
 
+// DefaultNFTTransferChannelOpts returns the default settings for creating an ics721 non-fungible token transfer
+// channel between the nft-transfer modules of two chains.
+func DefaultNFTTransferChannelOpts() CreateChannelOptions {
+	return CreateChannelOptions{
+		SourcePortName: NFTTransferPortID,
+		DestPortName:   NFTTransferPortID,
+		Order:          Unordered,
+		Version:        NFTTransferVersion,
+	}
+}
+
+// DefaultFeeChannelOpts returns the default settings for creating an ics20 fungible token transfer channel
+// wrapped by the ICS-29 fee middleware.
+func DefaultFeeChannelOpts() CreateChannelOptions {
+	return DefaultChannelOpts().WithFeeMiddleware()
+}
+
+// WithFeeMiddleware returns a copy of opts whose version negotiates the ICS-29 fee middleware
+// on top of the application version. Both chains must have the fee middleware wired to the ports.
+func (opts CreateChannelOptions) WithFeeMiddleware() CreateChannelOptions {
+	if opts.IsFeeEnabled() {
+		return opts
+	}
+	opts.Version = FeeVersion(opts.Version)
+	return opts
+}
+
+// FeeVersion returns the channel version negotiating the ICS-29 fee middleware on top of appVersion,
+// e.g. to upgrade an existing channel to a fee enabled one.
+func FeeVersion(appVersion string) string {
+	return string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{
+		FeeVersion: feetypes.Version,
+		AppVersion: appVersion,
+	}))
+}
+
+// IsFeeEnabled reports whether the channel version negotiates the ICS-29 fee middleware.
+func (opts CreateChannelOptions) IsFeeEnabled() bool {
+	metadata, err := feetypes.MetadataFromVersion(opts.Version)
+	return err == nil && metadata.FeeVersion == feetypes.Version
+}
+
 // Validate will check that the specified CreateChannelOptions are valid.
 func (opts CreateChannelOptions) Validate() error {
 	switch {
@@ -1,6 +1,7 @@
 package ibc
 
 import (
+	"context"
 	"testing"
 
 	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -35,6 +36,19 @@ func TestChannelOptsConfigured(t *testing.T) {
 	require.Error(t, opts.Validate())
 }
 
+func TestFeeChannelOpts(t *testing.T) {
+	opts := DefaultChannelOpts()
+	require.False(t, opts.IsFeeEnabled())
+
+	opts = DefaultFeeChannelOpts()
+	require.NoError(t, opts.Validate())
+	require.True(t, opts.IsFeeEnabled())
+	require.Equal(t, `{"fee_version":"ics29-1","app_version":"ics20-1"}`, opts.Version)
+
+	// Wrapping an already fee enabled version is a no-op.
+	require.Equal(t, opts, opts.WithFeeMiddleware())
+}
+
 func TestClientOptsConfigured(t *testing.T) {
 	// Test the default client opts
 	opts := DefaultClientOpts()
@@ -62,3 +76,65 @@ func TestClientOptsConfigured(t *testing.T) {
 	}
 	require.Error(t, opts.Validate())
 }
+
+// transferRelayer reports two clients of chain-a tracking chain-b, without their status like rly.
+type transferRelayer struct {
+	Relayer
+}
+
+func (transferRelayer) GetClients(context.Context, RelayerExecReporter, string) (ClientOutputs, error) {
+	return ClientOutputs{
+		{ClientID: "07-tendermint-0", ClientState: ClientState{ChainID: "chain-b"}},
+		{ClientID: "07-tendermint-1", ClientState: ClientState{ChainID: "chain-b"}},
+	}, nil
+}
+
+func (transferRelayer) GetConnections(context.Context, RelayerExecReporter, string) (ConnectionOutputs, error) {
+	return ConnectionOutputs{
+		{ID: "connection-0", ClientID: "07-tendermint-0"},
+		{ID: "connection-1", ClientID: "07-tendermint-1"},
+	}, nil
+}
+
+func (transferRelayer) GetChannels(context.Context, RelayerExecReporter, string) ([]ChannelOutput, error) {
+	return []ChannelOutput{
+		{ChannelID: "channel-0", PortID: "transfer", ConnectionHops: []string{"connection-0"}},
+		{ChannelID: "channel-1", PortID: "transfer", ConnectionHops: []string{"connection-1"}},
+	}, nil
+}
+
+type statusChain struct {
+	Chain
+	chainID  string
+	statuses map[string]string
+}
+
+func (c statusChain) Config() ChainConfig {
+	return ChainConfig{ChainID: c.chainID}
+}
+
+func (c statusChain) IbcClientQueryStatus(_ context.Context, clientID string) (string, error) {
+	return c.statuses[clientID], nil
+}
+
+func TestGetActiveTransferChannel(t *testing.T) {
+	ctx := context.Background()
+	src := statusChain{chainID: "chain-a", statuses: map[string]string{
+		"07-tendermint-0": ClientStatusExpired,
+		"07-tendermint-1": ClientStatusActive,
+	}}
+	dst := statusChain{chainID: "chain-b"}
+
+	// Without status, both clients are assumed to be active.
+	_, err := GetTransferChannel(ctx, transferRelayer{}, nil, "chain-a", "chain-b")
+	require.ErrorContains(t, err, "found multiple clients")
+
+	clients, err := GetClientsWithStatus(ctx, transferRelayer{}, nil, src)
+	require.NoError(t, err)
+	require.False(t, clients[0].IsActive())
+	require.True(t, clients[1].IsActive())
+
+	channel, err := GetActiveTransferChannel(ctx, transferRelayer{}, nil, src, dst)
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", channel.ChannelID)
+}
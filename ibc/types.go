@@ -317,6 +317,21 @@ type ConnectionOutputs []*ConnectionOutput
 type ClientOutput struct {
 	ClientID    string      `json:"client_id"`
 	ClientState ClientState `json:"client_state"`
+
+	// Status is one of the ClientStatus values, or empty if the relayer does not report it.
+	Status string `json:"status,omitempty"`
+}
+
+// The statuses of a light client, as reported by ibc-go.
+const (
+	ClientStatusActive  = "Active"
+	ClientStatusExpired = "Expired"
+	ClientStatusFrozen  = "Frozen"
+)
+
+// IsActive reports whether the client can be updated, i.e. it is not known to be expired or frozen.
+func (c ClientOutput) IsActive() bool {
+	return c.Status != ClientStatusExpired && c.Status != ClientStatusFrozen
 }
 
 type ClientState struct {
@@ -30,7 +30,7 @@ type Interchain struct {
 	built bool
 
 	// Map of relayer-chain pairs to address and mnemonic, set during Build().
-	// Not yet exposed through any exported API.
+	// Exposed through RelayerWallet and TrackRelayerWallets.
 	relayerWallets map[relayerChain]ibc.Wallet
 
 	// Map of chain to additional genesis wallets to include at chain start.
@@ -38,6 +38,10 @@ type Interchain struct {
 
 	// Set during Build and cleaned up in the Close method.
 	cs *chainSet
+
+	// Chains and links added after Build, which are built by BuildAdded.
+	pendingChains []ibc.Chain
+	pendingLinks  []relayerPath
 }
 
 type interchainLink struct {
@@ -76,6 +80,7 @@ type relayerPath struct {
 
 // AddChain adds the given chain to the Interchain,
 // using the chain ID reported by the chain's config.
+// Chains added after Build are started by BuildAdded.
 // If the given chain already exists,
 // or if another chain with the same configured chain ID exists, AddChain panics.
 func (ic *Interchain) AddChain(chain ibc.Chain, additionalGenesisWallets ...ibc.WalletAmount) *Interchain {
@@ -99,6 +104,9 @@ func (ic *Interchain) AddChain(chain ibc.Chain, additionalGenesisWallets ...ibc.
 	}
 
 	ic.chains[chain] = newID
+	if ic.built {
+		ic.pendingChains = append(ic.pendingChains, chain)
+	}
 
 	if len(additionalGenesisWallets) == 0 {
 		return ic
@@ -156,6 +164,7 @@ type InterchainLink struct {
 }
 
 // AddLink adds the given link to the Interchain.
+// Links added after Build are linked by BuildAdded.
 // If any validation fails, AddLink panics.
 func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 	if _, exists := ic.chains[link.Chain1]; !exists {
@@ -188,6 +197,54 @@ func (ic *Interchain) AddLink(link InterchainLink) *Interchain {
 		createChannelOpts: link.CreateChannelOpts,
 		createClientOpts:  link.CreateClientOpts,
 	}
+	if ic.built {
+		ic.pendingLinks = append(ic.pendingLinks, key)
+	}
+	return ic
+}
+
+// RemoveChain removes the given chain and every link to it from the Interchain.
+// The chain is not stopped; stopping its nodes (and relayer paths) is the responsibility of the caller.
+// If the chain was never added, RemoveChain panics.
+func (ic *Interchain) RemoveChain(chain ibc.Chain) *Interchain {
+	if _, exists := ic.chains[chain]; !exists {
+		panic(fmt.Errorf("chain %v was never added to Interchain", chain))
+	}
+
+	delete(ic.chains, chain)
+	delete(ic.AdditionalGenesisWallets, chain)
+	if ic.cs != nil {
+		delete(ic.cs.chains, chain)
+	}
+
+	for rp, link := range ic.links {
+		if link.chains[0] == chain || link.chains[1] == chain {
+			delete(ic.links, rp)
+		}
+	}
+
+	for rc := range ic.relayerWallets {
+		if rc.C == chain {
+			delete(ic.relayerWallets, rc)
+		}
+	}
+
+	pendingChains := ic.pendingChains[:0]
+	for _, c := range ic.pendingChains {
+		if c != chain {
+			pendingChains = append(pendingChains, c)
+		}
+	}
+	ic.pendingChains = pendingChains
+
+	pendingLinks := ic.pendingLinks[:0]
+	for _, rp := range ic.pendingLinks {
+		if _, exists := ic.links[rp]; exists {
+			pendingLinks = append(pendingLinks, rp)
+		}
+	}
+	ic.pendingLinks = pendingLinks
+
 	return ic
 }
 
@@ -260,7 +317,7 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 		}
 	}
 
-	if err := ic.configureRelayerKeys(ctx, rep); err != nil {
+	if err := ic.configureRelayerKeys(ctx, rep, ic.relayerChains()); err != nil {
 		// Error already wrapped with appropriate detail.
 		return err
 	}
@@ -271,8 +328,120 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 		return nil
 	}
 
+	return ic.linkPaths(ctx, rep, ic.links)
+}
+
+// BuildAdded builds the chains and links added with AddChain and AddLink after Build was called,
+// so chains and paths can be added to a running Interchain.
+//
+// New chains are initialized and started with a funded faucet account and any additional genesis wallets.
+// Relayer wallets for new chains are funded at genesis, relayer wallets for chains which were already
+// running are funded from that chain's faucet account. Unless opts.SkipPathCreation is set, new links are
+// created in their relayer. Blocks of new chains are not tracked in the block database.
+//
+// It is the caller's responsibility to restart the relayers to relay the new paths.
+func (ic *Interchain) BuildAdded(ctx context.Context, rep *testreporter.RelayerExecReporter, opts InterchainBuildOptions) error {
+	if !ic.built {
+		return fmt.Errorf("Interchain.BuildAdded called before Build")
+	}
+
+	newChains, newLinks := ic.pendingChains, ic.pendingLinks
+	ic.pendingChains, ic.pendingLinks = nil, nil
+
+	isNew := make(map[ibc.Chain]bool, len(newChains))
+	for _, c := range newChains {
+		isNew[c] = true
+	}
+
+	cs := newChainSet(ic.log, newChains)
+	if err := cs.Initialize(ctx, opts.TestName, opts.Client, opts.NetworkID); err != nil {
+		return fmt.Errorf("failed to initialize chains: %w", err)
+	}
+
+	// Only relayer-chain pairs without a wallet need to be configured.
+	newRelayerChains := make(map[ibc.Relayer][]ibc.Chain)
+	for r, chains := range ic.relayerChains() {
+		for _, c := range chains {
+			if _, ok := ic.relayerWallets[relayerChain{R: r, C: c}]; !ok {
+				newRelayerChains[r] = append(newRelayerChains[r], c)
+			}
+		}
+	}
+
+	if err := ic.addRelayerWallets(ctx, newRelayerChains); err != nil {
+		return err
+	}
+
+	faucetAddresses, err := cs.CreateCommonAccount(ctx, FaucetAccountKeyName)
+	if err != nil {
+		return fmt.Errorf("failed to create faucet accounts: %w", err)
+	}
+
+	walletAmounts := make(map[ibc.Chain][]ibc.WalletAmount, len(newChains))
+	for _, c := range newChains {
+		walletAmounts[c] = []ibc.WalletAmount{faucetWalletAmount(c, faucetAddresses[c])}
+
+		if ic.AdditionalGenesisWallets != nil {
+			walletAmounts[c] = append(walletAmounts[c], ic.AdditionalGenesisWallets[c]...)
+		}
+	}
+
+	for r, chains := range newRelayerChains {
+		for _, c := range chains {
+			if isNew[c] {
+				walletAmounts[c] = append(walletAmounts[c], relayerWalletAmount(c, ic.relayerWallets[relayerChain{R: r, C: c}]))
+			}
+		}
+	}
+
+	if err := cs.Start(ctx, opts.TestName, walletAmounts); err != nil {
+		return fmt.Errorf("failed to start chains: %w", err)
+	}
+
+	for _, c := range newChains {
+		ic.cs.chains[c] = struct{}{}
+
+		if err := CreatePenumbraClient(ctx, c, FaucetAccountKeyName); err != nil {
+			return err
+		}
+	}
+
+	// Chains that were already running can only fund a new relayer wallet through a transfer.
+	for r, chains := range newRelayerChains {
+		for _, c := range chains {
+			if isNew[c] {
+				continue
+			}
+
+			amount := relayerWalletAmount(c, ic.relayerWallets[relayerChain{R: r, C: c}])
+			if err := c.SendFunds(ctx, FaucetAccountKeyName, amount); err != nil {
+				return fmt.Errorf("failed to fund relayer %s wallet on chain %s: %w", ic.relayers[r], ic.chains[c], err)
+			}
+		}
+	}
+
+	if err := ic.configureRelayerKeys(ctx, rep, newRelayerChains); err != nil {
+		// Error already wrapped with appropriate detail.
+		return err
+	}
+
+	if opts.SkipPathCreation {
+		return nil
+	}
+
+	links := make(map[relayerPath]interchainLink, len(newLinks))
+	for _, rp := range newLinks {
+		links[rp] = ic.links[rp]
+	}
+
+	return ic.linkPaths(ctx, rep, links)
+}
+
+// linkPaths teaches each relayer about its links, then creates the clients, connections,
+// and channels for every link in parallel.
+func (ic *Interchain) linkPaths(ctx context.Context, rep *testreporter.RelayerExecReporter, links map[relayerPath]interchainLink) error {
 	// For every relayer link, teach the relayer about the link and create the link.
-	for rp, link := range ic.links {
+	for rp, link := range links {
 		rp := rp
 		link := link
 		c0 := link.chains[0]
@@ -289,7 +458,7 @@ func (ic *Interchain) Build(ctx context.Context, rep *testreporter.RelayerExecRe
 	// Now link the paths in parallel
 	// Creates clients, connections, and channels for each link/path.
 	var eg errgroup.Group
-	for rp, link := range ic.links {
+	for rp, link := range links {
 		rp := rp
 		link := link
 		c0 := link.chains[0]
@@ -341,6 +510,10 @@ func (ic *Interchain) WithLog(log *zap.Logger) *Interchain {
 // Close cleans up any resources created during Build,
 // and returns any relevant errors.
 func (ic *Interchain) Close() error {
+	if ic.cs == nil {
+		// Build was never called.
+		return nil
+	}
 	return ic.cs.Close()
 }
 
@@ -357,13 +530,7 @@ func (ic *Interchain) genesisWalletAmounts(ctx context.Context) (map[ibc.Chain][
 	// Add faucet for each chain first.
 	for c := range ic.chains {
 		// The values are nil at this point, so it is safe to directly assign the slice.
-		walletAmounts[c] = []ibc.WalletAmount{
-			{
-				Address: faucetAddresses[c],
-				Denom:   c.Config().Denom,
-				Amount:  math.NewInt(100_000_000_000_000), // Faucet wallet gets 100T units of denom.
-			},
-		}
+		walletAmounts[c] = []ibc.WalletAmount{faucetWalletAmount(c, faucetAddresses[c])}
 
 		if ic.AdditionalGenesisWallets != nil {
 			walletAmounts[c] = append(walletAmounts[c], ic.AdditionalGenesisWallets[c]...)
@@ -373,16 +540,28 @@ func (ic *Interchain) genesisWalletAmounts(ctx context.Context) (map[ibc.Chain][
 	// Then add all defined relayer wallets.
 	for rc, wallet := range ic.relayerWallets {
 		c := rc.C
-		walletAmounts[c] = append(walletAmounts[c], ibc.WalletAmount{
-			Address: wallet.FormattedAddress(),
-			Denom:   c.Config().Denom,
-			Amount:  math.NewInt(1_000_000_000_000), // Every wallet gets 1t units of denom.
-		})
+		walletAmounts[c] = append(walletAmounts[c], relayerWalletAmount(c, wallet))
 	}
 
 	return walletAmounts, nil
 }
 
+func faucetWalletAmount(c ibc.Chain, address string) ibc.WalletAmount {
+	return ibc.WalletAmount{
+		Address: address,
+		Denom:   c.Config().Denom,
+		Amount:  math.NewInt(100_000_000_000_000), // Faucet wallet gets 100T units of denom.
+	}
+}
+
+func relayerWalletAmount(c ibc.Chain, wallet ibc.Wallet) ibc.WalletAmount {
+	return ibc.WalletAmount{
+		Address: wallet.FormattedAddress(),
+		Denom:   c.Config().Denom,
+		Amount:  math.NewInt(1_000_000_000_000), // Every wallet gets 1t units of denom.
+	}
+}
+
 // generateRelayerWallets populates ic.relayerWallets.
 func (ic *Interchain) generateRelayerWallets(ctx context.Context) error {
 	if ic.relayerWallets != nil {
@@ -391,6 +570,11 @@ func (ic *Interchain) generateRelayerWallets(ctx context.Context) error {
 
 	relayerChains := ic.relayerChains()
 	ic.relayerWallets = make(map[relayerChain]ibc.Wallet, len(relayerChains))
+	return ic.addRelayerWallets(ctx, relayerChains)
+}
+
+// addRelayerWallets builds a new wallet in ic.relayerWallets for each relayer-chain pair.
+func (ic *Interchain) addRelayerWallets(ctx context.Context, relayerChains map[ibc.Relayer][]ibc.Chain) error {
 	for r, chains := range relayerChains {
 		for _, c := range chains {
 			// Just an ephemeral unique name, only for the local use of the keyring.
@@ -408,11 +592,11 @@ func (ic *Interchain) generateRelayerWallets(ctx context.Context) error {
 
 // configureRelayerKeys adds the chain configuration for each relayer
 // and adds the preconfigured key to the relayer for each relayer-chain.
-func (ic *Interchain) configureRelayerKeys(ctx context.Context, rep *testreporter.RelayerExecReporter) error {
+func (ic *Interchain) configureRelayerKeys(ctx context.Context, rep *testreporter.RelayerExecReporter, relayerChains map[ibc.Relayer][]ibc.Chain) error {
 	// Possible optimization: each relayer could be configured concurrently.
 	// But we are only testing with a single relayer so far, so we don't need this yet.
 
-	for r, chains := range ic.relayerChains() {
+	for r, chains := range relayerChains {
 		for _, c := range chains {
 			rpcAddr, grpcAddr := c.GetRPCAddress(), c.GetGRPCAddress()
 			if !r.UseDockerNetwork() {
@@ -452,6 +452,48 @@ func TestInterchain_AddNil(t *testing.T) {
 	})
 }
 
+func TestInterchain_RemoveChain(t *testing.T) {
+	cf := interchaintest.NewBuiltinChainFactory(zap.NewNop(), []*interchaintest.ChainSpec{
+		{Name: "gaia", ChainName: "g1", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-0"}},
+		{Name: "gaia", ChainName: "g2", Version: "v7.0.1", ChainConfig: ibc.ChainConfig{ChainID: "cosmoshub-1"}},
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+	gaia0, gaia1 := chains[0], chains[1]
+
+	var r rly.CosmosRelayer
+	ic := interchaintest.NewInterchain().
+		AddChain(gaia0).
+		AddChain(gaia1).
+		AddRelayer(&r, "r").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  gaia0,
+			Chain2:  gaia1,
+			Relayer: &r,
+			Path:    "p",
+		})
+
+	ic.RemoveChain(gaia1)
+
+	// The link was removed along with the chain, so the path name can be reused.
+	require.PanicsWithError(t, fmt.Sprintf("chain with name=%s and id=%s was never added to Interchain", "g2", "cosmoshub-1"), func() {
+		_ = ic.AddLink(interchaintest.InterchainLink{Chain1: gaia0, Chain2: gaia1, Relayer: &r, Path: "p"})
+	})
+
+	_ = ic.AddChain(gaia1).AddLink(interchaintest.InterchainLink{Chain1: gaia0, Chain2: gaia1, Relayer: &r, Path: "p"})
+
+	require.PanicsWithError(t, fmt.Sprintf("chain %v was never added to Interchain", gaia1), func() {
+		_ = ic.RemoveChain(gaia1).RemoveChain(gaia1)
+	})
+}
+
+func TestInterchain_BuildAddedBeforeBuild(t *testing.T) {
+	rep := testreporter.NewNopReporter()
+	err := interchaintest.NewInterchain().BuildAdded(context.Background(), rep.RelayerExecReporter(t), interchaintest.InterchainBuildOptions{})
+	require.EqualError(t, err, "Interchain.BuildAdded called before Build")
+}
+
 func assertTransactionIsValid(t *testing.T, resp sdk.TxResponse) {
 	require.NotNil(t, resp)
 	require.NotEqual(t, 0, resp.GasUsed)
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -19,15 +21,31 @@ const (
 	FlagRelayerUidGid       = "relayer-uidgid"
 	FlagRelayerStartupFlags = "relayer-startup-flags"
 	FlagAuthKey             = "auth-key"
+	FlagAPIKeys             = "api-keys"
+	FlagAuditLog            = "audit-log"
+	FlagFromSnapshot        = "from-snapshot"
 )
 
 var startCmd = &cobra.Command{
 	Use:     "start <config.json>",
 	Aliases: []string{"s", "run"},
 	Short:   "Starts up the chain of choice with the config name",
-	Args:    cobra.ExactArgs(1),
+	Args:    cobra.RangeArgs(0, 1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		fromSnapshot, _ := cmd.Flags().GetString(FlagFromSnapshot)
+		if len(args) == 0 && fromSnapshot == "" {
+			return fmt.Errorf("a config file or --%s is required", FlagFromSnapshot)
+		}
+		if len(args) > 0 && fromSnapshot != "" {
+			return fmt.Errorf("a config file can not be used with --%s, the snapshot's config is used", FlagFromSnapshot)
+		}
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		configPath := args[0]
+		configPath := ""
+		if len(args) > 0 {
+			configPath = args[0]
+		}
 		parentDir := GetDirectory()
 
 		if path.IsAbs(configPath) {
@@ -48,7 +66,7 @@ var startCmd = &cobra.Command{
 		relayerUidGid := cmd.Flag(FlagRelayerUidGid).Value.String()
 		relayerFlags := strings.Split(cmd.Flag(FlagRelayerStartupFlags).Value.String(), " ")
 
-		interchain.StartChain(parentDir, configPath, &types.AppStartConfig{
+		err := interchain.StartChain(parentDir, configPath, &types.AppStartConfig{
 			Address: apiAddr,
 			Port:    apiPort,
 
@@ -61,8 +79,16 @@ var startCmd = &cobra.Command{
 				StartupFlags: relayerFlags,
 			},
 
-			AuthKey: cmd.Flag(FlagAuthKey).Value.String(),
+			AuthKey:     cmd.Flag(FlagAuthKey).Value.String(),
+			APIKeysFile: cmd.Flag(FlagAPIKeys).Value.String(),
+			AuditLog:    cmd.Flag(FlagAuditLog).Value.String(),
+
+			FromSnapshot: cmd.Flag(FlagFromSnapshot).Value.String(),
 		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "local-ic: %v\n", err)
+			os.Exit(1)
+		}
 	},
 }
 
@@ -76,4 +102,8 @@ func init() {
 	startCmd.Flags().String(FlagRelayerStartupFlags, "--block-history=100", "override the default relayer startup flags")
 
 	startCmd.Flags().String(FlagAuthKey, "", "require an auth key to use the internal API")
+	startCmd.Flags().String(FlagAPIKeys, "", "JSON file of API keys with scopes (read, faucet, admin) required to use the internal API")
+	startCmd.Flags().String(FlagAuditLog, "", "file to record API requests to (default configs/audit.log when authentication is enabled)")
+
+	startCmd.Flags().String(FlagFromSnapshot, "", "restore the environment from a saved snapshot (in the snapshots/ folder)")
 }
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// AuditLog appends one JSON line per API request to a file.
+type AuditLog struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+type AuditEntry struct {
+	Time       string `json:"time"`
+	Client     string `json:"client"`
+	RemoteAddr string `json:"remote_addr"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Action     string `json:"action,omitempty"`
+	ChainID    string `json:"chain_id,omitempty"`
+	Allowed    bool   `json:"allowed"`
+	Error      string `json:"error,omitempty"`
+}
+
+// OpenAuditLog opens (or creates) the audit log file for appending.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLog{f: f}, nil
+}
+
+// Record writes the result of an authorization check. It is a no-op on a nil log.
+func (l *AuditLog) Record(r *http.Request, client, action, chainID string, err error) {
+	if l == nil {
+		return
+	}
+
+	entry := AuditEntry{
+		Time:       time.Now().UTC().Format(time.RFC3339),
+		Client:     client,
+		RemoteAddr: r.RemoteAddr,
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		Action:     action,
+		ChainID:    chainID,
+		Allowed:    err == nil,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		log.Default().Println(err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.f.Write(append(bz, '\n')); err != nil {
+		log.Default().Println("failed to write audit log:", err)
+	}
+}
+
+// Close closes the audit log file. It is a no-op on a nil log.
+func (l *AuditLog) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.f.Close()
+}
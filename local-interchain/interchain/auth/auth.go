@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/strangelove-ventures/localinterchain/interchain/util"
+)
+
+// Scope is a permission granted to an API key.
+type Scope string
+
+const (
+	// ScopeRead allows fetching information & running queries.
+	ScopeRead Scope = "read"
+	// ScopeFaucet allows requesting funds from the faucet.
+	ScopeFaucet Scope = "faucet"
+	// ScopeAdmin allows every action, including exec in containers & kill-all.
+	ScopeAdmin Scope = "admin"
+)
+
+// legacyKeyName is the client name of the key set with --auth-key.
+const legacyKeyName = "auth-key"
+
+var (
+	ErrUnauthorized = errors.New("missing or invalid API key")
+	ErrForbidden    = errors.New("API key is not allowed to perform this request")
+)
+
+type Key struct {
+	Name   string  `json:"name"`
+	Key    string  `json:"key"`
+	Scopes []Scope `json:"scopes"`
+}
+
+// Allows returns true if the key was granted the scope. Admin keys are allowed everything.
+func (k Key) Allows(scope Scope) bool {
+	return slices.Contains(k.Scopes, ScopeAdmin) || slices.Contains(k.Scopes, scope)
+}
+
+type KeysFile struct {
+	Keys []Key `json:"keys"`
+}
+
+// LoadKeys reads the API keys file.
+func LoadKeys(path string) ([]Key, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var kf KeysFile
+	if err := json.Unmarshal(bz, &kf); err != nil {
+		return nil, fmt.Errorf("failed to decode API keys file %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, k := range kf.Keys {
+		if k.Name == "" || k.Key == "" {
+			return nil, fmt.Errorf("API key requires a name and key: %s", k.Name)
+		}
+		if seen[k.Key] {
+			return nil, fmt.Errorf("API key of %s is used more than once", k.Name)
+		}
+		seen[k.Key] = true
+
+		for _, s := range k.Scopes {
+			if s != ScopeRead && s != ScopeFaucet && s != ScopeAdmin {
+				return nil, fmt.Errorf("API key %s has unknown scope %s", k.Name, s)
+			}
+		}
+	}
+
+	return kf.Keys, nil
+}
+
+// Authenticator checks the API key of every request against its scopes & records the result to the audit log.
+type Authenticator struct {
+	keys []Key
+	// The GET info routes were public with the legacy auth key, they are only protected by API keys.
+	protectInfo bool
+
+	audit *AuditLog
+}
+
+// NewAuthenticator creates an authenticator for the API keys. legacyKey, if set, is an admin key which
+// may also be passed as `auth_key` in the request body. With no keys at all, every request is allowed.
+func NewAuthenticator(keys []Key, legacyKey string, audit *AuditLog) *Authenticator {
+	a := &Authenticator{
+		keys:        slices.Clone(keys),
+		protectInfo: len(keys) > 0,
+		audit:       audit,
+	}
+
+	if legacyKey != "" {
+		a.keys = append(a.keys, Key{
+			Name:   legacyKeyName,
+			Key:    legacyKey,
+			Scopes: []Scope{ScopeAdmin},
+		})
+	}
+
+	return a
+}
+
+// Enabled returns true if requests must be authenticated.
+func (a *Authenticator) Enabled() bool {
+	return len(a.keys) > 0
+}
+
+// Check authorizes the request for the scope & records it to the audit log.
+// bodyKey is the `auth_key` of the request body, used when no key is set in the headers.
+func (a *Authenticator) Check(r *http.Request, bodyKey string, scope Scope, action, chainID string) error {
+	client, err := a.authorize(r, bodyKey, scope)
+	a.audit.Record(r, client, action, chainID, err)
+	return err
+}
+
+// Require wraps a handler so it is only served to clients with the scope.
+// Only rejected requests are recorded to the audit log.
+func (a *Authenticator) Require(scope Scope, next http.HandlerFunc) http.HandlerFunc {
+	return a.require(scope, true, next)
+}
+
+// RequireInfo wraps a GET info route so it is only served to clients with the read scope when API keys are
+// configured. With only the legacy auth key, info routes stay public.
+func (a *Authenticator) RequireInfo(next http.HandlerFunc) http.HandlerFunc {
+	return a.require(ScopeRead, a.protectInfo, next)
+}
+
+func (a *Authenticator) require(scope Scope, protect bool, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !protect {
+			next(w, r)
+			return
+		}
+
+		client, err := a.authorize(r, "", scope)
+		if err != nil {
+			a.audit.Record(r, client, "", "", err)
+			WriteError(w, err)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// authorize returns the name of the client making the request.
+func (a *Authenticator) authorize(r *http.Request, bodyKey string, scope Scope) (string, error) {
+	raw := keyFromHeaders(r)
+	if raw == "" {
+		raw = bodyKey
+	}
+
+	if !a.Enabled() {
+		return "anonymous", nil
+	}
+
+	if raw == "" {
+		return "anonymous", ErrUnauthorized
+	}
+
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(raw)) != 1 {
+			continue
+		}
+
+		if !k.Allows(scope) {
+			return k.Name, fmt.Errorf("%w: %s requires the %s scope", ErrForbidden, k.Name, scope)
+		}
+		return k.Name, nil
+	}
+
+	return "unknown", ErrUnauthorized
+}
+
+// keyFromHeaders returns the key of an `Authorization: Bearer <key>` or `X-API-Key: <key>` header.
+func keyFromHeaders(r *http.Request) string {
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return strings.TrimSpace(bearer)
+	}
+
+	return strings.TrimSpace(r.Header.Get("X-API-Key"))
+}
+
+// WriteError writes an authorization error with the matching HTTP status code.
+func WriteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrForbidden):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Is(err, ErrUnauthorized):
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+
+	util.WriteError(w, err)
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	keys := []Key{
+		{Name: "frontend", Key: "read-key", Scopes: []Scope{ScopeRead, ScopeFaucet}},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		authn     *Authenticator
+		key       string
+		scope     Scope
+		wantErr   error
+		wantActor string
+	}{
+		{name: "no keys allows everything", authn: NewAuthenticator(nil, "", nil), scope: ScopeAdmin, wantActor: "anonymous"},
+		// The legacy auth key protects every POST action, including read only ones.
+		{name: "legacy key requires a key for reads", authn: NewAuthenticator(nil, "legacy", nil), scope: ScopeRead, wantErr: ErrUnauthorized, wantActor: "anonymous"},
+		{name: "legacy key rejects unknown keys", authn: NewAuthenticator(nil, "legacy", nil), key: "wrong", scope: ScopeRead, wantErr: ErrUnauthorized, wantActor: "unknown"},
+		{name: "legacy key is admin", authn: NewAuthenticator(nil, "legacy", nil), key: "legacy", scope: ScopeAdmin, wantActor: legacyKeyName},
+		{name: "api keys require a key for reads", authn: NewAuthenticator(keys, "", nil), scope: ScopeRead, wantErr: ErrUnauthorized, wantActor: "anonymous"},
+		{name: "scope granted", authn: NewAuthenticator(keys, "", nil), key: "read-key", scope: ScopeFaucet, wantActor: "frontend"},
+		{name: "scope missing", authn: NewAuthenticator(keys, "", nil), key: "read-key", scope: ScopeAdmin, wantErr: ErrForbidden, wantActor: "frontend"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			actor, err := tt.authn.authorize(r, tt.key, tt.scope)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantActor, actor)
+		})
+	}
+}
+
+func TestRequireInfo(t *testing.T) {
+	ok := func(w http.ResponseWriter, r *http.Request) {}
+
+	// Info routes stay public with only the legacy auth key.
+	w := httptest.NewRecorder()
+	NewAuthenticator(nil, "legacy", nil).RequireInfo(ok)(w, httptest.NewRequest(http.MethodGet, "/info", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	authn := NewAuthenticator([]Key{{Name: "frontend", Key: "read-key", Scopes: []Scope{ScopeRead}}}, "", nil)
+	authn.RequireInfo(ok)(w, httptest.NewRequest(http.MethodGet, "/info", nil))
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/info", nil)
+	r.Header.Set("X-API-Key", "read-key")
+	authn.RequireInfo(ok)(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+}
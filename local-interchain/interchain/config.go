@@ -57,10 +57,7 @@ func LoadConfig(installDir, chainCfgFile string) (*types.Config, error) {
 
 	for i := range chains {
 		chain := chains[i]
-		chain.SetChainDefaults()
-		util.ReplaceStringValues(&chain, "%DENOM%", chain.Denom)
-		util.ReplaceStringValues(&chain, "%BIN%", chain.Binary)
-		util.ReplaceStringValues(&chain, "%CHAIN_ID%", chain.ChainID)
+		SetChainConfigDefaults(&chain)
 
 		chains[i] = chain
 
@@ -72,6 +69,14 @@ func LoadConfig(installDir, chainCfgFile string) (*types.Config, error) {
 	return config, nil
 }
 
+// SetChainConfigDefaults sets the default values of a chain config and replaces its variables.
+func SetChainConfigDefaults(chain *types.Chain) {
+	chain.SetChainDefaults()
+	util.ReplaceStringValues(chain, "%DENOM%", chain.Denom)
+	util.ReplaceStringValues(chain, "%BIN%", chain.Binary)
+	util.ReplaceStringValues(chain, "%CHAIN_ID%", chain.ChainID)
+}
+
 // ConfigurationOverrides creates a map of config file overrides for filenames, their keys, and values.
 func ConfigurationOverrides(cfg types.Chain) map[string]any {
 	var toml map[string]any
@@ -2,6 +2,7 @@ package interchain
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 )
 
@@ -13,48 +14,67 @@ type FakeI interface {
 
 type FakeTesting struct {
 	FakeName string
+
+	mu       sync.Mutex
+	cleanups []func()
 }
 
 // impl all of testing.T for FakeTesting
-func (t FakeTesting) Name() string {
+func (t *FakeTesting) Name() string {
 	return t.FakeName
 }
 
-func (t FakeTesting) Cleanup(func()) {
+// Cleanup registers a function to be called by RunCleanup, e.g. the docker resource cleanup of DockerSetup.
+func (t *FakeTesting) Cleanup(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
+// RunCleanup calls all registered cleanup functions in last added, first called order (same as testing.T).
+func (t *FakeTesting) RunCleanup() {
+	t.mu.Lock()
+	cleanups := t.cleanups
+	t.cleanups = nil
+	t.mu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
 }
 
-func (t FakeTesting) Skip(...any) {
+func (t *FakeTesting) Skip(...any) {
 }
 
-func (t FakeTesting) Parallel() {
+func (t *FakeTesting) Parallel() {
 }
 
-func (t FakeTesting) Failed() bool {
+func (t *FakeTesting) Failed() bool {
 	return false
 }
 
-func (t FakeTesting) Skipped() bool {
+func (t *FakeTesting) Skipped() bool {
 	return false
 }
 
-func (t FakeTesting) Error(...any) {
+func (t *FakeTesting) Error(...any) {
 }
 
-func (t FakeTesting) Errorf(format string, args ...any) {
+func (t *FakeTesting) Errorf(format string, args ...any) {
 }
 
-func (t FakeTesting) Fail() {
+func (t *FakeTesting) Fail() {
 }
 
-func (t FakeTesting) FailNow() {
+func (t *FakeTesting) FailNow() {
 }
 
-func (t FakeTesting) Fatal(...any) {
+func (t *FakeTesting) Fatal(...any) {
 }
 
-func (t FakeTesting) Helper() {
+func (t *FakeTesting) Helper() {
 }
 
-func (t FakeTesting) Logf(format string, args ...any) {
+func (t *FakeTesting) Logf(format string, args ...any) {
 	fmt.Printf(format, args...)
 }
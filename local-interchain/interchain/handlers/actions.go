@@ -3,29 +3,53 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/strangelove-ventures/interchaintest/v8"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/localinterchain/interchain/auth"
+	"github.com/strangelove-ventures/localinterchain/interchain/snapshot"
+	"github.com/strangelove-ventures/localinterchain/interchain/types"
 	"github.com/strangelove-ventures/localinterchain/interchain/util"
+	"golang.org/x/sync/errgroup"
 )
 
 type actions struct {
-	ctx  context.Context
-	ic   *interchaintest.Interchain
-	vals map[string][]*cosmos.ChainNode
-	cc   map[string]*cosmos.CosmosChain
+	ctx      context.Context
+	shutdown context.CancelFunc
+	ic       *interchaintest.Interchain
+	vals     map[string][]*cosmos.ChainNode
+	cc       map[string]*cosmos.CosmosChain
 
-	relayer ibc.Relayer
-	eRep    ibc.RelayerExecReporter
+	cm   ChainManager
+	eRep ibc.RelayerExecReporter
 
-	authKey string
+	auth *auth.Authenticator
+
+	config       *types.Config
+	installDir   string
+	chainCfgFile string
+}
+
+// ChainManager changes the chains & IBC paths of the running environment.
+type ChainManager interface {
+	AddChain(ctx context.Context, cfg types.Chain) error
+	AddIBCPath(ctx context.Context, path, chainID, counterpartyChainID string) error
+	RemoveChain(ctx context.Context, chainID string) error
+
+	Relayer() ibc.Relayer
+
+	// RLock is held while a handler uses the running chains, so they are not changed at the same time.
+	RLock()
+	RUnlock()
 }
 
 type ActionHandler struct {
@@ -37,19 +61,24 @@ type ActionHandler struct {
 }
 
 func NewActions(
-	ctx context.Context, ic *interchaintest.Interchain,
+	ctx context.Context, shutdown context.CancelFunc, ic *interchaintest.Interchain,
 	cosmosChains map[string]*cosmos.CosmosChain, vals map[string][]*cosmos.ChainNode,
-	relayer ibc.Relayer, eRep ibc.RelayerExecReporter,
-	authKey string,
+	cm ChainManager, eRep ibc.RelayerExecReporter,
+	authn *auth.Authenticator,
+	config *types.Config, installDir, chainCfgFile string,
 ) *actions {
 	return &actions{
-		ctx:     ctx,
-		ic:      ic,
-		vals:    vals,
-		cc:      cosmosChains,
-		relayer: relayer,
-		eRep:    eRep,
-		authKey: authKey,
+		ctx:          ctx,
+		shutdown:     shutdown,
+		ic:           ic,
+		vals:         vals,
+		cc:           cosmosChains,
+		cm:           cm,
+		eRep:         eRep,
+		auth:         authn,
+		config:       config,
+		installDir:   installDir,
+		chainCfgFile: chainCfgFile,
 	}
 }
 
@@ -61,14 +90,44 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if a.authKey != "" && ah.AuthKey != a.authKey {
-		util.WriteError(w, fmt.Errorf("invalid `auth_key`"))
+	action := ah.Action
+	if err := a.auth.Check(r, ah.AuthKey, actionScope(action), action, ah.ChainId); err != nil {
+		auth.WriteError(w, err)
 		return
 	}
 
-	action := ah.Action
 	if action == "kill-all" {
-		KillAll(a.ctx, a.ic, a.vals, a.relayer, a.eRep)
+		// Shutdown & cleanup happens in the same way as a SIGINT, after the response is sent.
+		util.Write(w, []byte(`{"kill-all":"shutting down"}`))
+		a.shutdown()
+		return
+	}
+
+	// Chain management actions change the running chains, so they must not hold the read lock.
+	switch action {
+	case "add-chain", "add_chain", "addChain":
+		util.Write(w, addChain(a, ah.Cmd))
+		return
+	case "add-ibc-path", "add_ibc_path", "addIbcPath":
+		util.Write(w, addIBCPath(a, ah.ChainId, ah.Cmd))
+		return
+	case "remove-chain", "remove_chain", "removeChain":
+		util.Write(w, removeChain(a, ah.ChainId))
+		return
+	}
+
+	relayer := a.cm.Relayer()
+
+	a.cm.RLock()
+	defer a.cm.RUnlock()
+
+	// Environment wide actions which do not target a single node.
+	switch action {
+	case "save-snapshot", "save_snapshot", "saveSnapshot":
+		util.Write(w, saveSnapshot(a, relayer, ah.Cmd))
+		return
+	case "list-snapshots", "list_snapshots", "listSnapshots":
+		util.Write(w, listSnapshots(a))
 		return
 	}
 
@@ -96,13 +155,7 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 	var stdout, stderr []byte
 
 	// parse out special commands if there are any.
-	cmdMap := make(map[string]string)
-	if strings.Contains(ah.Cmd, "=") {
-		for _, c := range strings.Split(ah.Cmd, ";") {
-			s := strings.Split(c, "=")
-			cmdMap[s[0]] = s[1]
-		}
-	}
+	cmdMap := parseCmdMap(ah.Cmd)
 
 	// Node / Docker Linux Actions
 	switch action {
@@ -150,19 +203,19 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 
 	// Relayer Actions if the above is not used.
 	if len(stdout) == 0 && len(stderr) == 0 && err == nil {
-		if err := a.relayerCheck(w, r); err != nil {
+		if err := relayerCheck(w, relayer); err != nil {
 			return
 		}
 
 		switch action {
 		case "stop-relayer", "stop_relayer", "stopRelayer":
-			err = a.relayer.StopRelayer(a.ctx, a.eRep)
+			err = relayer.StopRelayer(a.ctx, a.eRep)
 
 		case "start-relayer", "start_relayer", "startRelayer":
 			paths := strings.FieldsFunc(ah.Cmd, func(c rune) bool {
 				return c == ',' || c == ' '
 			})
-			err = a.relayer.StartRelayer(a.ctx, a.eRep, paths...)
+			err = relayer.StartRelayer(a.ctx, a.eRep, paths...)
 
 		case "relayer", "relayer-exec", "relayer_exec", "relayerExec":
 			if !strings.Contains(ah.Cmd, "--home") {
@@ -170,13 +223,13 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 				cmd = append(cmd, "--home", "/home/relayer")
 			}
 
-			res := a.relayer.Exec(a.ctx, a.eRep, cmd, []string{})
+			res := relayer.Exec(a.ctx, a.eRep, cmd, []string{})
 			stdout = []byte(res.Stdout)
 			stderr = []byte(res.Stderr)
 			err = res.Err
 
 		case "get_channels", "get-channels", "getChannels":
-			res, err := a.relayer.GetChannels(a.ctx, a.eRep, chainId)
+			res, err := relayer.GetChannels(a.ctx, a.eRep, chainId)
 			if err != nil {
 				util.WriteError(w, err)
 				return
@@ -205,10 +258,37 @@ func (a *actions) PostActions(w http.ResponseWriter, r *http.Request) {
 	util.Write(w, []byte(output))
 }
 
-func (a *actions) relayerCheck(w http.ResponseWriter, r *http.Request) error {
+// actionScope returns the API key scope required to run an action.
+func actionScope(action string) auth.Scope {
+	switch action {
+	case "q", "query",
+		"dump-contract-state",
+		"get_channels", "get-channels", "getChannels",
+		"list-snapshots", "list_snapshots", "listSnapshots":
+		return auth.ScopeRead
+	case "faucet":
+		return auth.ScopeFaucet
+	default:
+		return auth.ScopeAdmin
+	}
+}
+
+// parseCmdMap parses special commands in the `key=value;key2=value2` format.
+func parseCmdMap(cmd string) map[string]string {
+	cmdMap := make(map[string]string)
+	if strings.Contains(cmd, "=") {
+		for _, c := range strings.Split(cmd, ";") {
+			k, v, _ := strings.Cut(c, "=")
+			cmdMap[k] = v
+		}
+	}
+	return cmdMap
+}
+
+func relayerCheck(w http.ResponseWriter, relayer ibc.Relayer) error {
 	var err error = nil
 
-	if a.relayer == nil {
+	if relayer == nil {
 		util.Write(w, []byte(`{"error":"relayer not configured for this setup"}`))
 		err = fmt.Errorf("relayer not configured for this setup")
 	}
@@ -216,21 +296,38 @@ func (a *actions) relayerCheck(w http.ResponseWriter, r *http.Request) error {
 	return err
 }
 
-func KillAll(ctx context.Context, ic *interchaintest.Interchain, vals map[string][]*cosmos.ChainNode, relayer ibc.Relayer, eRep ibc.RelayerExecReporter) {
+// KillAll stops the relayer and every node container. Errors are collected so all resources are attempted.
+func KillAll(ctx context.Context, ic *interchaintest.Interchain, vals map[string][]*cosmos.ChainNode, relayer ibc.Relayer, eRep ibc.RelayerExecReporter) error {
+	var errs []error
+
 	if relayer != nil {
 		if err := relayer.StopRelayer(ctx, eRep); err != nil {
-			panic(err)
+			errs = append(errs, fmt.Errorf("failed to stop relayer: %w", err))
 		}
 	}
 
+	var mu sync.Mutex
+	var eg errgroup.Group
 	for _, v := range vals {
 		for _, c := range v {
-			go c.StopContainer(ctx) // nolint:errcheck
+			c := c
+			eg.Go(func() error {
+				if err := c.StopContainer(ctx); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("failed to stop %s: %w", c.Name(), err))
+					mu.Unlock()
+				}
+				return nil
+			})
 		}
 	}
+	_ = eg.Wait()
+
+	if err := ic.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close interchain: %w", err))
+	}
 
-	ic.Close()
-	<-ctx.Done()
+	return errors.Join(errs...)
 }
 
 func dumpContractState(r *http.Request, cmdMap map[string]string, a *actions, val *cosmos.ChainNode) []byte {
@@ -281,3 +378,64 @@ func faucet(r *http.Request, cmdMap map[string]string, ctx context.Context, a *a
 
 	return []byte(fmt.Sprintf(`{"sent_funds":"%s"}`, amount))
 }
+
+func saveSnapshot(a *actions, relayer ibc.Relayer, cmd string) []byte {
+	name := strings.TrimPrefix(strings.TrimSpace(cmd), "name=")
+
+	md, err := snapshot.Save(a.ctx, a.installDir, name, a.chainCfgFile, a.config, a.cc, relayer, a.eRep)
+	if err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	return []byte(fmt.Sprintf(`{"saved_snapshot":"%s","location":"%s"}`, md.Name, snapshot.Dir(a.installDir, md.Name)))
+}
+
+func listSnapshots(a *actions) []byte {
+	names, err := snapshot.List(a.installDir)
+	if err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	jsonRes, err := json.Marshal(names)
+	if err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	return jsonRes
+}
+
+func addChain(a *actions, cmd string) []byte {
+	var cfg types.Chain
+	if err := json.Unmarshal([]byte(cmd), &cfg); err != nil {
+		return []byte(fmt.Sprintf(`{"error":"failed to decode chain config: %s"}`, err))
+	}
+
+	if err := a.cm.AddChain(a.ctx, cfg); err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	return []byte(fmt.Sprintf(`{"added_chain":"%s"}`, cfg.ChainID))
+}
+
+func addIBCPath(a *actions, chainId, cmd string) []byte {
+	cmdMap := parseCmdMap(cmd)
+	path, ok1 := cmdMap["path"]
+	counterparty, ok2 := cmdMap["counterparty"]
+	if !ok1 || !ok2 {
+		return []byte(`{"error":"'path' or 'counterparty' not found in commands"}`)
+	}
+
+	if err := a.cm.AddIBCPath(a.ctx, path, chainId, counterparty); err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	return []byte(fmt.Sprintf(`{"added_ibc_path":"%s"}`, path))
+}
+
+func removeChain(a *actions, chainId string) []byte {
+	if err := a.cm.RemoveChain(a.ctx, chainId); err != nil {
+		return []byte(fmt.Sprintf(`{"error":"%s"}`, err))
+	}
+
+	return []byte(fmt.Sprintf(`{"removed_chain":"%s"}`, chainId))
+}
@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/localinterchain/interchain/auth"
+	"github.com/strangelove-ventures/localinterchain/interchain/types"
+	"github.com/strangelove-ventures/localinterchain/interchain/util"
+)
+
+// faucetKeyName is the genesis faucet account created for every chain.
+const faucetKeyName = "faucet"
+
+type faucets struct {
+	ctx    context.Context
+	cc     map[string]*cosmos.CosmosChain
+	cm     ChainManager
+	auth   *auth.Authenticator
+	config *types.Config
+
+	mu     sync.Mutex
+	chains map[string]*chainFaucet
+}
+
+// chainFaucet tracks the requests of every address to a chain's faucet.
+type chainFaucet struct {
+	chainID  string
+	coins    sdk.Coins
+	prefix   string
+	interval time.Duration
+	max      int
+
+	// sendMu serializes the faucet account's transactions & the limit checks which depend on them.
+	sendMu   sync.Mutex
+	requests map[string]*faucetRecord
+}
+
+type faucetRecord struct {
+	last  time.Time
+	count int
+}
+
+type FaucetRequest struct {
+	Address string `json:"address"`
+}
+
+type FaucetResponse struct {
+	Address string `json:"address"`
+	Sent    string `json:"sent"`
+	TxHash  string `json:"tx_hash"`
+}
+
+func NewFaucets(
+	ctx context.Context,
+	cosmosChains map[string]*cosmos.CosmosChain,
+	cm ChainManager,
+	authn *auth.Authenticator,
+	config *types.Config,
+) *faucets {
+	return &faucets{
+		ctx:    ctx,
+		cc:     cosmosChains,
+		cm:     cm,
+		auth:   authn,
+		config: config,
+		chains: make(map[string]*chainFaucet),
+	}
+}
+
+// GetFaucetPage serves a web page to request funds from a chain's faucet.
+func (f *faucets) GetFaucetPage(w http.ResponseWriter, r *http.Request) {
+	f.cm.RLock()
+	defer f.cm.RUnlock()
+
+	cf, err := f.get(mux.Vars(r)["chain_id"])
+	if err != nil {
+		writeStatusError(w, http.StatusNotFound, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := faucetPage.Execute(w, map[string]string{
+		"chainID": cf.chainID,
+		"coins":   cf.coins.String(),
+		"prefix":  cf.prefix,
+	}); err != nil {
+		util.WriteError(w, err)
+	}
+}
+
+// PostFaucet sends the chain's faucet amount to the requested address.
+func (f *faucets) PostFaucet(w http.ResponseWriter, r *http.Request) {
+	chainID := mux.Vars(r)["chain_id"]
+
+	if err := f.auth.Check(r, "", auth.ScopeFaucet, "faucet", chainID); err != nil {
+		auth.WriteError(w, err)
+		return
+	}
+
+	var req FaucetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeStatusError(w, http.StatusBadRequest, fmt.Errorf("failed to decode json: %w", err))
+		return
+	}
+
+	f.cm.RLock()
+	defer f.cm.RUnlock()
+
+	cf, err := f.get(chainID)
+	if err != nil {
+		writeStatusError(w, http.StatusNotFound, err)
+		return
+	}
+
+	if _, err := sdk.GetFromBech32(req.Address, cf.prefix); err != nil {
+		writeStatusError(w, http.StatusBadRequest, fmt.Errorf("invalid %s address: %w", cf.prefix, err))
+		return
+	}
+
+	cf.sendMu.Lock()
+	defer cf.sendMu.Unlock()
+
+	if retryAfter, err := cf.allow(req.Address, time.Now()); err != nil {
+		if retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		}
+		writeStatusError(w, http.StatusTooManyRequests, err)
+		return
+	}
+
+	txHash, err := f.cc[chainID].GetNode().ExecTx(f.ctx, faucetKeyName,
+		"bank", "send", faucetKeyName, req.Address, cf.coins.String(),
+	)
+	if err != nil {
+		util.WriteError(w, fmt.Errorf("failed to send funds: %w", err))
+		return
+	}
+
+	cf.record(req.Address, time.Now())
+
+	jsonRes, err := json.Marshal(FaucetResponse{
+		Address: req.Address,
+		Sent:    cf.coins.String(),
+		TxHash:  txHash,
+	})
+	if err != nil {
+		util.WriteError(w, err)
+		return
+	}
+
+	util.Write(w, jsonRes)
+}
+
+// get returns the faucet of a running chain which has one configured. The chain manager lock must be held.
+func (f *faucets) get(chainID string) (*chainFaucet, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.cc[chainID]; !ok {
+		delete(f.chains, chainID)
+		return nil, fmt.Errorf("chain_id %s not found", chainID)
+	}
+
+	if cf, ok := f.chains[chainID]; ok {
+		return cf, nil
+	}
+
+	var cfg *types.Chain
+	for i := range f.config.Chains {
+		if f.config.Chains[i].ChainID == chainID {
+			cfg = &f.config.Chains[i]
+			break
+		}
+	}
+	if cfg == nil || cfg.Faucet == nil {
+		return nil, fmt.Errorf("faucet is not enabled for %s", chainID)
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(cfg.Faucet.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid faucet amount for %s: %w", chainID, err)
+	}
+
+	interval, err := time.ParseDuration(cfg.Faucet.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid faucet interval for %s: %w", chainID, err)
+	}
+
+	cf := &chainFaucet{
+		chainID:  chainID,
+		coins:    coins,
+		prefix:   cfg.Bech32Prefix,
+		interval: interval,
+		max:      cfg.Faucet.MaxPerAddress,
+		requests: make(map[string]*faucetRecord),
+	}
+	f.chains[chainID] = cf
+
+	return cf, nil
+}
+
+// allow returns an error, and how long to wait if it is temporary, when the address reached its limits.
+func (c *chainFaucet) allow(address string, now time.Time) (time.Duration, error) {
+	rec, ok := c.requests[address]
+	if !ok {
+		return 0, nil
+	}
+
+	if c.max > 0 && rec.count >= c.max {
+		return 0, fmt.Errorf("%s reached the limit of %d faucet requests", address, c.max)
+	}
+
+	if wait := rec.last.Add(c.interval).Sub(now); wait > 0 {
+		return wait, fmt.Errorf("%s must wait %s before the next faucet request", address, wait.Round(time.Second))
+	}
+
+	return 0, nil
+}
+
+// record counts a successful request of the address.
+func (c *chainFaucet) record(address string, now time.Time) {
+	rec, ok := c.requests[address]
+	if !ok {
+		rec = &faucetRecord{}
+		c.requests[address] = rec
+	}
+
+	rec.last = now
+	rec.count++
+}
+
+func writeStatusError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	util.WriteError(w, err)
+}
+
+var faucetPage = template.Must(template.New("faucet").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>{{.chainID}} faucet</title>
+  <style>
+    body { font-family: sans-serif; max-width: 40em; margin: 4em auto; }
+    input { width: 100%; padding: 0.5em; margin: 0.25em 0 1em; box-sizing: border-box; }
+    button { padding: 0.5em 2em; }
+    pre { background: #f4f4f4; padding: 1em; white-space: pre-wrap; word-break: break-all; }
+  </style>
+</head>
+<body>
+  <h1>{{.chainID}} faucet</h1>
+  <p>Sends <b>{{.coins}}</b> per request.</p>
+  <form id="faucet">
+    <label>Address <input id="address" placeholder="{{.prefix}}1..." required></label>
+    <label>API key (if required) <input id="key" type="password"></label>
+    <button type="submit">Request funds</button>
+  </form>
+  <pre id="result"></pre>
+  <script>
+    const key = document.getElementById("key");
+    key.value = localStorage.getItem("local-ic-key") || "";
+    document.getElementById("faucet").addEventListener("submit", async (e) => {
+      e.preventDefault();
+      localStorage.setItem("local-ic-key", key.value);
+      const result = document.getElementById("result");
+      result.textContent = "Sending...";
+      const res = await fetch(window.location.pathname, {
+        method: "POST",
+        headers: key.value ? { "X-API-Key": key.value } : {},
+        body: JSON.stringify({ address: document.getElementById("address").value }),
+      });
+      result.textContent = await res.text();
+    });
+  </script>
+</body>
+</html>
+`))
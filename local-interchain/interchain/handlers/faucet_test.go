@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/localinterchain/interchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaucetLimits(t *testing.T) {
+	const (
+		alice = "cosmos1alice"
+		bob   = "cosmos1bob"
+	)
+
+	type request struct {
+		chainID   string
+		address   string
+		at        time.Duration // since the first request
+		wantErr   bool
+		wantRetry time.Duration
+	}
+
+	for _, tt := range []struct {
+		name     string
+		max      int
+		requests []request
+	}{
+		{
+			name: "interval resets",
+			requests: []request{
+				{chainID: "localchain-1", address: alice},
+				{chainID: "localchain-1", address: alice, at: 20 * time.Second, wantErr: true, wantRetry: 40 * time.Second},
+				{chainID: "localchain-1", address: alice, at: time.Minute},
+				{chainID: "localchain-1", address: alice, at: time.Minute + time.Second, wantErr: true, wantRetry: 59 * time.Second},
+			},
+		},
+		{
+			name: "max count rejects after the interval",
+			max:  2,
+			requests: []request{
+				{chainID: "localchain-1", address: alice},
+				{chainID: "localchain-1", address: alice, at: time.Minute},
+				{chainID: "localchain-1", address: alice, at: time.Hour, wantErr: true},
+			},
+		},
+		{
+			name: "no max count",
+			requests: []request{
+				{chainID: "localchain-1", address: alice},
+				{chainID: "localchain-1", address: alice, at: time.Minute},
+				{chainID: "localchain-1", address: alice, at: 2 * time.Minute},
+				{chainID: "localchain-1", address: alice, at: 3 * time.Minute},
+			},
+		},
+		{
+			name: "addresses are limited independently",
+			max:  1,
+			requests: []request{
+				{chainID: "localchain-1", address: alice},
+				{chainID: "localchain-1", address: bob},
+				{chainID: "localchain-1", address: alice, at: time.Hour, wantErr: true},
+				{chainID: "localchain-1", address: bob, at: time.Hour, wantErr: true},
+			},
+		},
+		{
+			name: "chains are limited independently",
+			requests: []request{
+				{chainID: "localchain-1", address: alice},
+				{chainID: "localchain-2", address: alice, at: 30 * time.Second},
+				{chainID: "localchain-1", address: alice, at: 40 * time.Second, wantErr: true, wantRetry: 20 * time.Second},
+				{chainID: "localchain-2", address: alice, at: 40 * time.Second, wantErr: true, wantRetry: 50 * time.Second},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &types.Config{}
+			cc := make(map[string]*cosmos.CosmosChain)
+			for _, chainID := range []string{"localchain-1", "localchain-2"} {
+				config.Chains = append(config.Chains, types.Chain{
+					ChainID:      chainID,
+					Bech32Prefix: "cosmos",
+					Faucet:       &types.Faucet{Amount: "10000000uatom", Interval: "1m", MaxPerAddress: tt.max},
+				})
+				cc[chainID] = nil
+			}
+			f := NewFaucets(nil, cc, nil, nil, config)
+
+			start := time.Now()
+			for i, req := range tt.requests {
+				cf, err := f.get(req.chainID)
+				require.NoError(t, err)
+
+				now := start.Add(req.at)
+				retryAfter, err := cf.allow(req.address, now)
+				if req.wantErr {
+					require.Error(t, err, "request %d", i)
+				} else {
+					require.NoError(t, err, "request %d", i)
+					cf.record(req.address, now)
+				}
+				require.Equal(t, req.wantRetry, retryAfter, "request %d", i)
+			}
+		})
+	}
+}
+
+func TestFaucetNotEnabled(t *testing.T) {
+	config := &types.Config{Chains: []types.Chain{{ChainID: "localchain-1", Bech32Prefix: "cosmos"}}}
+	f := NewFaucets(nil, map[string]*cosmos.CosmosChain{"localchain-1": nil}, nil, nil, config)
+
+	_, err := f.get("localchain-1")
+	require.ErrorContains(t, err, "faucet is not enabled")
+
+	_, err = f.get("localchain-2")
+	require.ErrorContains(t, err, "not found")
+}
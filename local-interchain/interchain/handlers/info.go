@@ -22,11 +22,11 @@ type info struct {
 	InstallDir string
 
 	// used to get information about state of the container
-	ctx     context.Context
-	ic      *interchaintest.Interchain
-	vals    map[string][]*cosmos.ChainNode
-	relayer ibc.Relayer
-	eRep    ibc.RelayerExecReporter
+	ctx  context.Context
+	ic   *interchaintest.Interchain
+	vals map[string][]*cosmos.ChainNode
+	cm   ChainManager
+	eRep ibc.RelayerExecReporter
 
 	cc map[string]*cosmos.CosmosChain
 
@@ -40,19 +40,19 @@ func NewInfo(
 	ic *interchaintest.Interchain,
 	cosmosChains map[string]*cosmos.CosmosChain,
 	vals map[string][]*cosmos.ChainNode,
-	relayer ibc.Relayer,
+	cm ChainManager,
 	eRep ibc.RelayerExecReporter,
 ) *info {
 	return &info{
 		Config:     cfg,
 		InstallDir: installDir,
 
-		ctx:     ctx,
-		ic:      ic,
-		vals:    vals,
-		cc:      cosmosChains,
-		relayer: relayer,
-		eRep:    eRep,
+		ctx:  ctx,
+		ic:   ic,
+		vals: vals,
+		cc:   cosmosChains,
+		cm:   cm,
+		eRep: eRep,
 	}
 }
 
@@ -63,6 +63,9 @@ type GetInfo struct {
 }
 
 func (i *info) GetInfo(w http.ResponseWriter, r *http.Request) {
+	i.cm.RLock()
+	defer i.cm.RUnlock()
+
 	form := r.URL.Query()
 
 	res, ok := form["request"]
@@ -9,14 +9,16 @@ import (
 	"path/filepath"
 
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/localinterchain/interchain/auth"
 	"github.com/strangelove-ventures/localinterchain/interchain/util"
 )
 
 type upload struct {
 	ctx  context.Context
 	vals map[string][]*cosmos.ChainNode
+	cm   ChainManager
 
-	authKey string
+	auth *auth.Authenticator
 }
 
 type Uploader struct {
@@ -29,11 +31,12 @@ type Uploader struct {
 	AuthKey string `json:"auth_key,omitempty"`
 }
 
-func NewUploader(ctx context.Context, vals map[string][]*cosmos.ChainNode, authKey string) *upload {
+func NewUploader(ctx context.Context, vals map[string][]*cosmos.ChainNode, cm ChainManager, authn *auth.Authenticator) *upload {
 	return &upload{
-		ctx:     ctx,
-		vals:    vals,
-		authKey: authKey,
+		ctx:  ctx,
+		vals: vals,
+		cm:   cm,
+		auth: authn,
 	}
 }
 
@@ -45,8 +48,8 @@ func (u *upload) PostUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if u.authKey != "" && u.authKey != upload.AuthKey {
-		util.WriteError(w, fmt.Errorf("invalid `auth_key`"))
+	if err := u.auth.Check(r, upload.AuthKey, auth.ScopeAdmin, "upload", upload.ChainId); err != nil {
+		auth.WriteError(w, err)
 		return
 	}
 
@@ -56,6 +59,9 @@ func (u *upload) PostUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	u.cm.RLock()
+	defer u.cm.RUnlock()
+
 	chainId := upload.ChainId
 	if _, ok := u.vals[chainId]; !ok {
 		util.Write(w, []byte(fmt.Sprintf(`{"error":"chain_id %s not found"}`, chainId)))
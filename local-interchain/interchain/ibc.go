@@ -50,7 +50,7 @@ func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chain
 		chain1 := chains[c[0]]
 		chain2 := chains[c[1]]
 
-		channel1, err := ibc.GetTransferChannel(ctx, r, eRep, chain1.Config().ChainID, chain2.Config().ChainID)
+		channel1, err := ibc.GetActiveTransferChannel(ctx, r, eRep, chain1, chain2)
 		if err != nil {
 			panic(err)
 		}
@@ -61,7 +61,7 @@ func GetChannelConnections(ctx context.Context, ibcpaths map[string][]int, chain
 		})
 
 		// this a duplicate?
-		channel2, err := ibc.GetTransferChannel(ctx, r, eRep, chain2.Config().ChainID, chain1.Config().ChainID)
+		channel2, err := ibc.GetActiveTransferChannel(ctx, r, eRep, chain2, chain1)
 		if err != nil {
 			panic(err)
 		}
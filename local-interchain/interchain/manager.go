@@ -0,0 +1,395 @@
+package interchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/docker/docker/client"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	interchaintestrelayer "github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/rly"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/localinterchain/interchain/handlers"
+	"github.com/strangelove-ventures/localinterchain/interchain/types"
+	"go.uber.org/zap"
+)
+
+const relayerName = "relay"
+
+var _ handlers.ChainManager = (*chainManager)(nil)
+
+// chainManager owns the running chains & relayer so chains and IBC paths can be changed after startup.
+type chainManager struct {
+	// opMu serializes changes to the environment, mu guards the fields read by the API handlers.
+	opMu sync.Mutex
+	mu   sync.RWMutex
+
+	logger     *zap.Logger
+	installDir string
+	testName   string
+
+	ic      *interchaintest.Interchain
+	client  *client.Client
+	network string
+	fakeT   *FakeTesting
+	eRep    *testreporter.RelayerExecReporter
+
+	config  *types.Config
+	chains  []ibc.Chain // same order as config.Chains
+	cc      map[string]*cosmos.CosmosChain
+	vals    map[string][]*cosmos.ChainNode
+	relayer ibc.Relayer
+}
+
+func newChainManager(
+	logger *zap.Logger, installDir, testName string,
+	ic *interchaintest.Interchain, client *client.Client, network string, fakeT *FakeTesting, eRep *testreporter.RelayerExecReporter,
+	config *types.Config, chains []ibc.Chain, vals map[string][]*cosmos.ChainNode,
+) *chainManager {
+	return &chainManager{
+		logger:     logger,
+		installDir: installDir,
+		testName:   testName,
+		ic:         ic,
+		client:     client,
+		network:    network,
+		fakeT:      fakeT,
+		eRep:       eRep,
+		config:     config,
+		chains:     chains,
+		cc:         make(map[string]*cosmos.CosmosChain),
+		vals:       vals,
+	}
+}
+
+func (m *chainManager) RLock()   { m.mu.RLock() }
+func (m *chainManager) RUnlock() { m.mu.RUnlock() }
+
+func (m *chainManager) Relayer() ibc.Relayer {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.relayer
+}
+
+// ensureRelayer builds the relayer from the config the first time a relayer is required.
+func (m *chainManager) ensureRelayer() ibc.Relayer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.relayer != nil {
+		return m.relayer
+	}
+
+	rlyCfg := m.config.Relayer
+	rf := interchaintest.NewBuiltinRelayerFactory(
+		ibc.CosmosRly,
+		m.logger,
+		interchaintestrelayer.CustomDockerImage(
+			rlyCfg.DockerImage.Repository,
+			rlyCfg.DockerImage.Version,
+			rlyCfg.DockerImage.UidGid,
+		),
+		interchaintestrelayer.StartupFlags(rlyCfg.StartupFlags...),
+	)
+
+	// This also just needs the name.
+	m.relayer = rf.Build(m.fakeT, m.client, m.network)
+	m.ic.AddRelayer(m.relayer, relayerName)
+
+	return m.relayer
+}
+
+// trackChains saves the cosmos chains (and their validators) so the API handlers can use them.
+func (m *chainManager) trackChains(chains ...ibc.Chain) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, chain := range chains {
+		if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
+			chainID := cosmosChain.Config().ChainID
+			m.cc[chainID] = cosmosChain
+			m.vals[chainID] = cosmosChain.Validators
+		}
+	}
+}
+
+// ibcPaths returns ibc-path-name -> index of the chains using the path.
+func (m *chainManager) ibcPaths() map[string][]int {
+	ibcpaths := make(map[string][]int)
+	for idx, cfg := range m.config.Chains {
+		for _, path := range cfg.IBCPaths {
+			ibcpaths[path] = append(ibcpaths[path], idx)
+		}
+	}
+	return ibcpaths
+}
+
+func (m *chainManager) chainIndex(chainID string) int {
+	for idx, cfg := range m.config.Chains {
+		if cfg.ChainID == chainID {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (m *chainManager) buildOptions() interchaintest.InterchainBuildOptions {
+	return interchaintest.InterchainBuildOptions{
+		TestName:  m.testName,
+		Client:    m.client,
+		NetworkID: m.network,
+	}
+}
+
+// AddChain starts a new chain from a chain config snippet.
+// IBC paths are not allowed in the snippet, they are added with AddIBCPath once the chain is running.
+func (m *chainManager) AddChain(ctx context.Context, cfg types.Chain) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	if len(cfg.IBCPaths) > 0 {
+		return fmt.Errorf("ibc_paths can not be set when adding a chain, add them once the chain is running")
+	}
+
+	chainSpec, err := chainSpecFromConfig(&cfg)
+	if err != nil {
+		return err
+	}
+
+	if m.chainIndex(cfg.ChainID) != -1 {
+		return fmt.Errorf("chain_id %s already exists", cfg.ChainID)
+	}
+
+	cf := interchaintest.NewBuiltinChainFactory(m.logger, []*interchaintest.ChainSpec{chainSpec})
+	chains, err := cf.Chains(m.testName)
+	if err != nil {
+		return err
+	}
+	chain := chains[0]
+
+	chainCfg := &types.Config{Chains: []types.Chain{cfg}}
+	genesisWallets := SetupGenesisWallets(chainCfg, chains)
+
+	if err := addChain(m.ic, chain, genesisWallets[chain]...); err != nil {
+		return err
+	}
+
+	if err := m.ic.BuildAdded(ctx, m.eRep, m.buildOptions()); err != nil {
+		m.ic.RemoveChain(chain)
+		return fmt.Errorf("failed to build chain %s: %w", cfg.ChainID, err)
+	}
+
+	m.mu.Lock()
+	m.config.Chains = append(m.config.Chains, cfg)
+	m.chains = append(m.chains, chain)
+	m.mu.Unlock()
+
+	m.trackChains(chain)
+
+	AddGenesisKeysToKeyring(ctx, chainCfg, chains)
+	PostStartupCommands(ctx, chainCfg, chains)
+
+	m.dumpChainsInfo(ctx)
+
+	return nil
+}
+
+// AddIBCPath links two running chains through the relayer (client, connection & transfer channel),
+// then restarts the relayer to relay the new path.
+func (m *chainManager) AddIBCPath(ctx context.Context, path, chainID, counterpartyChainID string) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	if path == "" {
+		return fmt.Errorf("ibc path name is required")
+	}
+
+	if _, ok := m.ibcPaths()[path]; ok {
+		return fmt.Errorf("ibc path '%s' already exists", path)
+	}
+
+	idx1, idx2 := m.chainIndex(chainID), m.chainIndex(counterpartyChainID)
+	if idx1 == -1 || idx2 == -1 {
+		return fmt.Errorf("chain_id '%s' or '%s' not found", chainID, counterpartyChainID)
+	}
+	if idx1 == idx2 {
+		return fmt.Errorf("ibc path '%s' must be between two different chains", path)
+	}
+
+	relayer := m.ensureRelayer()
+
+	link := interchaintest.InterchainLink{
+		Chain1:  m.chains[idx1],
+		Chain2:  m.chains[idx2],
+		Path:    path,
+		Relayer: relayer,
+	}
+	if err := addLink(m.ic, link); err != nil {
+		return err
+	}
+
+	if err := relayer.StopRelayer(ctx, m.eRep); err != nil {
+		return fmt.Errorf("failed to stop relayer: %w", err)
+	}
+
+	buildErr := m.ic.BuildAdded(ctx, m.eRep, m.buildOptions())
+	if buildErr == nil {
+		m.mu.Lock()
+		m.config.Chains[idx1].IBCPaths = append(m.config.Chains[idx1].IBCPaths, path)
+		m.config.Chains[idx2].IBCPaths = append(m.config.Chains[idx2].IBCPaths, path)
+		m.mu.Unlock()
+	}
+
+	// Always restart the relayer so the existing paths keep relaying.
+	if err := m.startRelayer(ctx); err != nil {
+		return err
+	}
+
+	if buildErr != nil {
+		return fmt.Errorf("failed to link ibc path '%s': %w", path, buildErr)
+	}
+
+	m.dumpChainsInfo(ctx)
+
+	return nil
+}
+
+// RemoveChain stops & removes a chain's nodes, and every IBC path to it.
+func (m *chainManager) RemoveChain(ctx context.Context, chainID string) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	idx := m.chainIndex(chainID)
+	if idx == -1 {
+		return fmt.Errorf("chain_id '%s' not found", chainID)
+	}
+
+	chain := m.chains[idx]
+	removedPaths := m.config.Chains[idx].IBCPaths
+
+	// Remove the chain from the API first so it is no longer used while shutting down.
+	m.mu.Lock()
+	delete(m.cc, chainID)
+	delete(m.vals, chainID)
+	m.config.Chains = append(m.config.Chains[:idx:idx], m.config.Chains[idx+1:]...)
+	m.chains = append(m.chains[:idx:idx], m.chains[idx+1:]...)
+	for i, cfg := range m.config.Chains {
+		paths := []string{}
+		for _, p := range cfg.IBCPaths {
+			if !slices.Contains(removedPaths, p) {
+				paths = append(paths, p)
+			}
+		}
+		m.config.Chains[i].IBCPaths = paths
+	}
+	m.mu.Unlock()
+
+	m.ic.RemoveChain(chain)
+
+	if relayer := m.Relayer(); relayer != nil && len(removedPaths) > 0 {
+		if err := relayer.StopRelayer(ctx, m.eRep); err != nil {
+			return fmt.Errorf("failed to stop relayer: %w", err)
+		}
+
+		// Allows the path names to be used again. Only rly saves paths to its home,
+		// hermes keeps them in memory and overwrites them when a path name is reused.
+		if rlyRelayer, ok := relayer.(*rly.CosmosRelayer); ok {
+			for _, path := range removedPaths {
+				res := rlyRelayer.Exec(ctx, m.eRep, []string{"rly", "paths", "delete", path, "--home", rlyRelayer.HomeDir()}, nil)
+				if res.Err != nil {
+					log.Println("Failed to delete relayer path", path, res.Err)
+				}
+			}
+		}
+
+		if err := m.startRelayer(ctx); err != nil {
+			return err
+		}
+	}
+
+	if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
+		if err := cosmosChain.StopAllNodes(ctx); err != nil {
+			return fmt.Errorf("failed to stop chain %s: %w", chainID, err)
+		}
+	}
+
+	m.dumpChainsInfo(ctx)
+
+	return nil
+}
+
+// startRelayer starts the relayer for all current IBC paths, if there are any.
+func (m *chainManager) startRelayer(ctx context.Context) error {
+	relayer := m.Relayer()
+	if relayer == nil {
+		return nil
+	}
+
+	paths := make([]string, 0)
+	for path := range m.ibcPaths() {
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	sort.Strings(paths)
+
+	if err := relayer.StartRelayer(ctx, m.eRep, paths...); err != nil {
+		return fmt.Errorf("failed to start relayer: %w", err)
+	}
+
+	return nil
+}
+
+// dumpChainsInfo saves the current runtime chain information to the logs.json file.
+func (m *chainManager) dumpChainsInfo(ctx context.Context) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	connections := GetChannelConnections(ctx, m.ibcPaths(), m.chains, m.ic, m.relayer, m.eRep)
+	DumpChainsInfoToLogs(m.installDir, m.config, m.chains, connections)
+}
+
+// chainSpecFromConfig applies the config defaults to a chain and returns its chain spec.
+// Invalid configs panic while loading at startup, at runtime they are returned as an error instead.
+func chainSpecFromConfig(cfg *types.Chain) (spec *interchaintest.ChainSpec, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid chain config: %v", r)
+		}
+	}()
+
+	SetChainConfigDefaults(cfg)
+	_, spec = CreateChainConfigs(*cfg)
+
+	return spec, nil
+}
+
+// addChain and addLink return the panics from the interchain validation as errors.
+func addChain(ic *interchaintest.Interchain, chain ibc.Chain, additionalGenesisWallets ...ibc.WalletAmount) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	ic.AddChain(chain, additionalGenesisWallets...)
+	return nil
+}
+
+func addLink(ic *interchaintest.Interchain, link interchaintest.InterchainLink) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	ic.AddLink(link)
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/strangelove-ventures/interchaintest/v8"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/localinterchain/interchain/auth"
 	ictypes "github.com/strangelove-ventures/localinterchain/interchain/types"
 	"github.com/strangelove-ventures/localinterchain/interchain/util"
 
@@ -22,35 +23,41 @@ type Route struct {
 
 func NewRouter(
 	ctx context.Context,
+	shutdown context.CancelFunc,
 	ic *interchaintest.Interchain,
 	config *ictypes.Config,
 	cosmosChains map[string]*cosmos.CosmosChain,
 	vals map[string][]*cosmos.ChainNode,
-	relayer ibc.Relayer,
-	authKey string,
+	cm handlers.ChainManager,
+	authn *auth.Authenticator,
 	eRep ibc.RelayerExecReporter,
 	installDir string,
+	chainCfgFile string,
 ) *mux.Router {
 	r := mux.NewRouter()
 
-	infoH := handlers.NewInfo(config, installDir, ctx, ic, cosmosChains, vals, relayer, eRep)
-	r.HandleFunc("/info", infoH.GetInfo).Methods(http.MethodGet)
+	infoH := handlers.NewInfo(config, installDir, ctx, ic, cosmosChains, vals, cm, eRep)
+	r.HandleFunc("/info", authn.RequireInfo(infoH.GetInfo)).Methods(http.MethodGet)
 
-	actionsH := handlers.NewActions(ctx, ic, cosmosChains, vals, relayer, eRep, authKey)
+	actionsH := handlers.NewActions(ctx, shutdown, ic, cosmosChains, vals, cm, eRep, authn, config, installDir, chainCfgFile)
 	r.HandleFunc("/", actionsH.PostActions).Methods(http.MethodPost)
 
-	uploaderH := handlers.NewUploader(ctx, vals, authKey)
+	uploaderH := handlers.NewUploader(ctx, vals, cm, authn)
 	r.HandleFunc("/upload", uploaderH.PostUpload).Methods(http.MethodPost)
 
+	faucetH := handlers.NewFaucets(ctx, cosmosChains, cm, authn, config)
+	r.HandleFunc("/faucet/{chain_id}", faucetH.GetFaucetPage).Methods(http.MethodGet)
+	r.HandleFunc("/faucet/{chain_id}", faucetH.PostFaucet).Methods(http.MethodPost)
+
 	availableRoutes := getAllMethods(*r)
-	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+	r.HandleFunc("/", authn.RequireInfo(func(w http.ResponseWriter, r *http.Request) {
 		jsonRes, err := json.MarshalIndent(availableRoutes, "", "  ")
 		if err != nil {
 			util.WriteError(w, err)
 			return
 		}
 		util.Write(w, jsonRes)
-	}).Methods(http.MethodGet)
+	})).Methods(http.MethodGet)
 
 	return r
 }
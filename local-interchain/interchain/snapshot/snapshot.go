@@ -0,0 +1,370 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/localinterchain/interchain/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// Snapshots are saved to <install-dir>/snapshots/<name>/ with the following layout:
+//
+//	snapshot.json          metadata & the chain config used to start the environment
+//	logs.json              the running chains information at the time of the snapshot
+//	<chain_id>/<node>.tar  the home directory (data, config & keyring) of every node
+//	relayer.tar            the relayer home directory, if a relayer is configured
+const (
+	metadataFile = "snapshot.json"
+	logsFile     = "logs.json"
+	relayerFile  = "relayer.tar"
+)
+
+type Metadata struct {
+	Name      string          `json:"name"`
+	CreatedAt int64           `json:"created_at"`
+	ChainFile string          `json:"chain_config_file"`
+	Chains    []ChainSnapshot `json:"chains"`
+	Relayer   bool            `json:"relayer"`
+	Config    *types.Config   `json:"config"`
+}
+
+type ChainSnapshot struct {
+	ChainID string   `json:"chain_id"`
+	Height  int64    `json:"height"`
+	Nodes   []string `json:"nodes"`
+}
+
+// relayerHome is implemented by relayers which keep their state in a docker volume.
+type relayerHome interface {
+	HomeDir() string
+	WriteFileToHomeDir(ctx context.Context, relativePath string, contents []byte) error
+}
+
+// Dir returns the directory a snapshot is saved to.
+func Dir(installDir, name string) string {
+	return filepath.Join(installDir, "snapshots", name)
+}
+
+// List returns the names of all snapshots saved in the install directory.
+func List(installDir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(installDir, "snapshots"))
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, e := range entries {
+		if _, err := os.Stat(filepath.Join(installDir, "snapshots", e.Name(), metadataFile)); err == nil {
+			names = append(names, e.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// Load reads the metadata of a previously saved snapshot.
+func Load(installDir, name string) (*Metadata, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(filepath.Join(Dir(installDir, name), metadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s not found: %w", name, err)
+	}
+
+	var md Metadata
+	if err := json.Unmarshal(bz, &md); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", name, err)
+	}
+
+	if md.Config == nil {
+		return nil, fmt.Errorf("snapshot %s does not contain a chain config", name)
+	}
+
+	return &md, nil
+}
+
+// Save checkpoints the running environment to a named snapshot on disk.
+// Every node is paused while its home directory is archived so the data is consistent.
+func Save(
+	ctx context.Context,
+	installDir, name, chainCfgFile string,
+	config *types.Config,
+	cosmosChains map[string]*cosmos.CosmosChain,
+	relayer ibc.Relayer,
+	eRep ibc.RelayerExecReporter,
+) (*Metadata, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	dir := Dir(installDir, name)
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	md := &Metadata{
+		Name:      name,
+		CreatedAt: time.Now().Unix(),
+		ChainFile: chainCfgFile,
+		Chains:    []ChainSnapshot{},
+		Config:    config,
+	}
+
+	chainIDs := make([]string, 0, len(cosmosChains))
+	for chainID := range cosmosChains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+
+	for _, chainID := range chainIDs {
+		cs, err := saveChain(ctx, dir, cosmosChains[chainID])
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s: %w", chainID, err)
+		}
+		md.Chains = append(md.Chains, *cs)
+	}
+
+	if relayer != nil {
+		if err := saveRelayer(ctx, dir, relayer, eRep); err != nil {
+			return nil, fmt.Errorf("failed to snapshot the relayer: %w", err)
+		}
+		md.Relayer = true
+	}
+
+	// Running chains info is informational only, it is regenerated on restore.
+	if bz, err := os.ReadFile(filepath.Join(installDir, "configs", "logs.json")); err == nil {
+		if err := os.WriteFile(filepath.Join(dir, logsFile), bz, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, metadataFile), bz, 0644); err != nil {
+		return nil, err
+	}
+
+	return md, nil
+}
+
+func saveChain(ctx context.Context, dir string, chain *cosmos.CosmosChain) (*ChainSnapshot, error) {
+	chainID := chain.Config().ChainID
+
+	height, err := chain.Height(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, chainID), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	nodes := chain.Nodes()
+	for _, n := range nodes {
+		if err := n.PauseContainer(ctx); err != nil {
+			return nil, err
+		}
+	}
+	defer func() {
+		for _, n := range nodes {
+			_ = n.UnpauseContainer(ctx)
+		}
+	}()
+
+	cs := &ChainSnapshot{
+		ChainID: chainID,
+		Height:  height,
+		Nodes:   []string{},
+	}
+
+	for _, n := range nodes {
+		// Exec runs in a separate container which mounts the node's volume, so it works while the node is paused.
+		stdout, stderr, err := n.Exec(ctx, []string{"tar", "-cf", "-", "-C", n.HomeDir(), "."}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("archiving %s home: %w (%s)", n.Name(), err, stderr)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, chainID, n.Name()+".tar"), stdout, 0644); err != nil {
+			return nil, err
+		}
+
+		cs.Nodes = append(cs.Nodes, n.Name())
+	}
+
+	return cs, nil
+}
+
+func saveRelayer(ctx context.Context, dir string, relayer ibc.Relayer, eRep ibc.RelayerExecReporter) error {
+	r, ok := relayer.(relayerHome)
+	if !ok {
+		return fmt.Errorf("relayer %T does not support snapshots", relayer)
+	}
+
+	res := relayer.Exec(ctx, eRep, []string{"tar", "-cf", "-", "-C", r.HomeDir(), "."}, nil)
+	if res.Err != nil {
+		return fmt.Errorf("archiving relayer home: %w (%s)", res.Err, res.Stderr)
+	}
+
+	return os.WriteFile(filepath.Join(dir, relayerFile), res.Stdout, 0644)
+}
+
+// Restore replaces the state of freshly built chains and relayer with the snapshot's.
+// It must be called after the interchain is built and before the relayer is started.
+func Restore(
+	ctx context.Context,
+	installDir string,
+	md *Metadata,
+	cosmosChains map[string]*cosmos.CosmosChain,
+	relayer ibc.Relayer,
+	eRep ibc.RelayerExecReporter,
+) error {
+	dir := Dir(installDir, md.Name)
+
+	var eg errgroup.Group
+	for _, cs := range md.Chains {
+		cs := cs
+
+		chain, ok := cosmosChains[cs.ChainID]
+		if !ok {
+			return fmt.Errorf("chain %s from snapshot %s is not running", cs.ChainID, md.Name)
+		}
+
+		eg.Go(func() error {
+			if err := restoreChain(ctx, dir, chain, cs); err != nil {
+				return fmt.Errorf("failed to restore %s: %w", cs.ChainID, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	if md.Relayer && relayer != nil {
+		if err := restoreRelayer(ctx, dir, relayer, eRep); err != nil {
+			return fmt.Errorf("failed to restore the relayer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func restoreChain(ctx context.Context, dir string, chain *cosmos.CosmosChain, cs ChainSnapshot) error {
+	nodes := chain.Nodes()
+	if len(nodes) != len(cs.Nodes) {
+		return fmt.Errorf("snapshot has %d nodes, chain has %d", len(cs.Nodes), len(nodes))
+	}
+
+	if err := chain.StopAllNodes(ctx); err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		archive, err := os.ReadFile(filepath.Join(dir, cs.ChainID, n.Name()+".tar"))
+		if err != nil {
+			return err
+		}
+
+		if _, stderr, err := n.Exec(ctx, []string{"sh", "-c", fmt.Sprintf("rm -rf %s/*", n.HomeDir())}, nil); err != nil {
+			return fmt.Errorf("clearing %s home: %w (%s)", n.Name(), err, stderr)
+		}
+
+		if err := n.CreateNodeContainer(ctx); err != nil {
+			return err
+		}
+
+		if err := n.DockerClient.CopyToContainer(ctx, n.ContainerID(), n.HomeDir(), bytes.NewReader(archive), dockertypes.CopyToContainerOptions{
+			CopyUIDGID: true,
+		}); err != nil {
+			return fmt.Errorf("copying snapshot to %s: %w", n.Name(), err)
+		}
+	}
+
+	for _, n := range nodes {
+		if err := n.StartContainer(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func restoreRelayer(ctx context.Context, dir string, relayer ibc.Relayer, eRep ibc.RelayerExecReporter) error {
+	r, ok := relayer.(relayerHome)
+	if !ok {
+		return fmt.Errorf("relayer %T does not support snapshots", relayer)
+	}
+
+	f, err := os.Open(filepath.Join(dir, relayerFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	res := relayer.Exec(ctx, eRep, []string{"sh", "-c", fmt.Sprintf("rm -rf %s/*", r.HomeDir())}, nil)
+	if res.Err != nil {
+		return fmt.Errorf("clearing relayer home: %w (%s)", res.Err, res.Stderr)
+	}
+
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+
+		if err := r.WriteFileToHomeDir(ctx, strings.TrimPrefix(hdr.Name, "./"), content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("snapshot name is required")
+	}
+
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return fmt.Errorf("invalid snapshot name: %s", name)
+	}
+
+	return nil
+}
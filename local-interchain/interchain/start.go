@@ -2,64 +2,137 @@ package interchain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"math"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/strangelove-ventures/interchaintest/v8"
 	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
-	interchaintestrelayer "github.com/strangelove-ventures/interchaintest/v8/relayer"
 	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
-	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/strangelove-ventures/localinterchain/interchain/auth"
+	"github.com/strangelove-ventures/localinterchain/interchain/handlers"
 	"github.com/strangelove-ventures/localinterchain/interchain/router"
+	"github.com/strangelove-ventures/localinterchain/interchain/snapshot"
 	"github.com/strangelove-ventures/localinterchain/interchain/types"
-	"go.uber.org/zap"
 )
 
-func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
+// shutdownTimeout bounds how long the cleanup of docker resources may take after a shutdown is requested.
+const shutdownTimeout = 2 * time.Minute
+
+// StartChain builds and runs the environment until SIGINT / SIGTERM or the kill-all action is received.
+// All docker resources are cleaned up before returning, in every phase. A nil error is returned when the
+// environment was shut down on request and cleaned up successfully.
+func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) (retErr error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	var relayer ibc.Relayer
+	// Cancel the context on a signal so every phase (startup, build, API serving) unwinds through the cleanup below.
+	ctx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	go func() {
+		<-ctx.Done()
+		// Restore the default signal behavior so a second signal force quits during cleanup.
+		stopSignals()
+	}()
+
 	var eRep *testreporter.RelayerExecReporter
+	var server *http.Server
+	var auditLog *auth.AuditLog
+	var fakeT *FakeTesting
+	var mgr *chainManager
 
 	vals := make(map[string][]*cosmos.ChainNode)
 	ic := interchaintest.NewInterchain()
-	defer ic.Close()
-
-	// TODO: cleanup on ctrl + c
-	// Properly cleanup at the start, during build, and after the REST API is created
-	// The following only works at the start and after the REST API is created.
-	//
-	// c := make(chan os.Signal, 1)
-	// signal.Notify(c, os.Interrupt)
-	// go func() {
-	// 	for sig := range c {
-	// 		log.Printf("Closing from signal: %s\n", sig)
-	// 		handlers.KillAll(ctx, ic, vals, relayer, eRep)
-	// 	}
-	// }()
+
+	defer func() {
+		interrupted := ctx.Err() != nil
+		if interrupted {
+			log.Println("Shutting down, cleaning up docker resources...")
+		}
+
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cleanupCancel()
+
+		var errs []error
+		if server != nil {
+			if err := server.Shutdown(cleanupCtx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to shutdown API server: %w", err))
+			}
+		}
+		if err := auditLog.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close audit log: %w", err))
+		}
+
+		var relayer ibc.Relayer
+		if mgr != nil {
+			relayer = mgr.Relayer()
+		}
+
+		if err := handlers.KillAll(cleanupCtx, ic, vals, relayer, eRep); err != nil {
+			errs = append(errs, err)
+		}
+
+		// Removes all containers, volumes & the network created for this environment.
+		if fakeT != nil {
+			fakeT.RunCleanup()
+		}
+
+		WriteRunningChains(installDir, []byte("{}"))
+
+		// An interrupted build or startup is an expected way to exit.
+		if interrupted && errors.Is(retErr, context.Canceled) {
+			retErr = nil
+		}
+		retErr = errors.Join(append([]error{retErr}, errs...)...)
+
+		if retErr == nil {
+			log.Println("Shutdown complete")
+		}
+	}()
 
 	// Logger for ICTest functions only.
 	logger, err := InitLogger()
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	config, err := LoadConfig(installDir, chainCfgFile)
-	if err != nil {
-		// try again with .json, then if it still fails - panic
-		config, err = LoadConfig(installDir, chainCfgFile+".json")
+	var config *types.Config
+	var snapshotMd *snapshot.Metadata
+	if ac.FromSnapshot != "" {
+		// The snapshot's chain config & file name are reused so node names (and peers) match the saved state.
+		snapshotMd, err = snapshot.Load(installDir, ac.FromSnapshot)
 		if err != nil {
-			panic(err)
+			return err
+		}
+
+		config, chainCfgFile = snapshotMd.Config, snapshotMd.ChainFile
+		log.Println("Restoring from snapshot:", snapshot.Dir(installDir, snapshotMd.Name))
+	} else {
+		config, err = LoadConfig(installDir, chainCfgFile)
+		if err != nil {
+			// try again with .json, then if it still fails - error
+			config, err = LoadConfig(installDir, chainCfgFile+".json")
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	config.Relayer = ac.Relayer
 
+	authn, auditLog, err := newAuthenticator(installDir, ac)
+	if err != nil {
+		return err
+	}
+
 	WriteRunningChains(installDir, []byte("{}"))
 
 	// ibc-path-name -> index of []cosmos.CosmosChain
@@ -78,7 +151,7 @@ func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
 	}
 
 	if err := VerifyIBCPaths(ibcpaths); err != nil {
-		log.Fatal("VerifyIBCPaths", err)
+		return fmt.Errorf("VerifyIBCPaths: %w", err)
 	}
 
 	// Create chain factory for all the chains
@@ -88,7 +161,7 @@ func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
 	name := strings.ReplaceAll(chainCfgFile, ".json", "") + "ic"
 	chains, err := cf.Chains(name)
 	if err != nil {
-		log.Fatal("cf.Chains", err)
+		return fmt.Errorf("cf.Chains: %w", err)
 	}
 
 	for _, chain := range chains {
@@ -96,35 +169,23 @@ func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
 	}
 	ic.AdditionalGenesisWallets = SetupGenesisWallets(config, chains)
 
-	fakeT := FakeTesting{
+	fakeT = &FakeTesting{
 		FakeName: name,
 	}
 
 	// Base setup
 	rep := testreporter.NewNopReporter()
-	eRep = rep.RelayerExecReporter(&fakeT)
+	eRep = rep.RelayerExecReporter(fakeT)
 
 	client, network := interchaintest.DockerSetup(fakeT)
 
+	// The manager owns the chains & relayer, so they can be changed through the API after startup.
+	mgr = newChainManager(logger, installDir, name, ic, client, network, fakeT, eRep, config, chains, vals)
+
 	// setup a relayer if we have IBC paths to use.
+	var relayer ibc.Relayer
 	if len(ibcpaths) > 0 {
-		rlyCfg := config.Relayer
-
-		relayerType, relayerName := ibc.CosmosRly, "relay"
-		rf := interchaintest.NewBuiltinRelayerFactory(
-			relayerType,
-			logger,
-			interchaintestrelayer.CustomDockerImage(
-				rlyCfg.DockerImage.Repository,
-				rlyCfg.DockerImage.Version,
-				rlyCfg.DockerImage.UidGid,
-			),
-			interchaintestrelayer.StartupFlags(rlyCfg.StartupFlags...),
-		)
-
-		// This also just needs the name.
-		relayer = rf.Build(fakeT, client, network)
-		ic = ic.AddRelayer(relayer, relayerName)
+		relayer = mgr.ensureRelayer()
 
 		// Add links between chains
 		LinkIBCPaths(ibcpaths, chains, ic, relayer)
@@ -135,72 +196,89 @@ func StartChain(installDir, chainCfgFile string, ac *types.AppStartConfig) {
 		TestName:         name,
 		Client:           client,
 		NetworkID:        network,
-		SkipPathCreation: false,
+		SkipPathCreation: snapshotMd != nil, // paths already exist in the snapshot's state
 		// BlockDatabaseFile: interchaintest.DefaultBlockDatabaseFilepath(),
 	})
 	if err != nil {
-		logger.Fatal("ic.Build", zap.Error(err))
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("ic.Build: %w", err)
 	}
 
-	if relayer != nil && len(ibcpaths) > 0 {
-		paths := make([]string, 0, len(ibcpaths))
-		for k := range ibcpaths {
-			paths = append(paths, k)
-		}
+	mgr.trackChains(chains...)
+	cosmosChains := mgr.cc
 
-		if err := relayer.StartRelayer(ctx, eRep, paths...); err != nil {
-			log.Fatal("relayer.StartRelayer", err)
+	if snapshotMd != nil {
+		if err := snapshot.Restore(ctx, installDir, snapshotMd, cosmosChains, relayer, eRep); err != nil {
+			return fmt.Errorf("snapshot.Restore: %w", err)
 		}
+	}
 
-		defer func() {
-			if err := relayer.StopRelayer(ctx, eRep); err != nil {
-				log.Fatal("relayer.StopRelayer", err)
-			}
-		}()
+	if err := mgr.startRelayer(ctx); err != nil {
+		return err
 	}
 
-	for _, chain := range chains {
-		if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
-			chainID := cosmosChain.Config().ChainID
-			vals[chainID] = cosmosChain.Validators
-		}
+	config.Server = types.RestServer{
+		Host: ac.Address,
+		Port: fmt.Sprintf("%d", ac.Port),
 	}
 
 	// Starts a non blocking REST server to take action on the chain.
+	// The kill-all action cancels the context to shutdown the same way a signal does.
+	server = &http.Server{
+		Addr:    fmt.Sprintf("%s:%s", config.Server.Host, config.Server.Port),
+		Handler: router.NewRouter(ctx, cancel, ic, config, cosmosChains, vals, mgr, authn, eRep, installDir, chainCfgFile),
+	}
 	go func() {
-		cosmosChains := map[string]*cosmos.CosmosChain{}
-		for _, chain := range chains {
-			if cosmosChain, ok := chain.(*cosmos.CosmosChain); ok {
-				cosmosChains[cosmosChain.Config().ChainID] = cosmosChain
-			}
-		}
-
-		r := router.NewRouter(ctx, ic, config, cosmosChains, vals, relayer, ac.AuthKey, eRep, installDir)
-
-		config.Server = types.RestServer{
-			Host: ac.Address,
-			Port: fmt.Sprintf("%d", ac.Port),
-		}
-
-		server := fmt.Sprintf("%s:%s", config.Server.Host, config.Server.Port)
-		if err := http.ListenAndServe(server, r); err != nil {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Default().Println(err)
 		}
 	}()
 
-	AddGenesisKeysToKeyring(ctx, config, chains)
+	// A restored snapshot already contains the genesis keys and the result of the startup commands.
+	if snapshotMd == nil {
+		AddGenesisKeysToKeyring(ctx, config, chains)
 
-	// run commands for each server after startup. Iterate chain configs
-	PostStartupCommands(ctx, config, chains)
-
-	connections := GetChannelConnections(ctx, ibcpaths, chains, ic, relayer, eRep)
+		// run commands for each server after startup. Iterate chain configs
+		PostStartupCommands(ctx, config, chains)
+	}
 
 	// Save to logs.json file for runtime chain information.
-	DumpChainsInfoToLogs(installDir, config, chains, connections)
+	mgr.dumpChainsInfo(ctx)
 
-	log.Println("\nLocal-IC API is running on ", fmt.Sprintf("http://%s:%s", config.Server.Host, config.Server.Port))
+	log.Println("\nLocal-IC API is running on ", fmt.Sprintf("http://%s", server.Addr))
 
-	if err = testutil.WaitForBlocks(ctx, math.MaxInt, chains[0]); err != nil {
-		log.Fatal("WaitForBlocks StartChain: ", err)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// newAuthenticator loads the API keys & opens the audit log. The audit log is only opened by default when
+// authentication is enabled.
+func newAuthenticator(installDir string, ac *types.AppStartConfig) (*auth.Authenticator, *auth.AuditLog, error) {
+	var keys []auth.Key
+	if ac.APIKeysFile != "" {
+		var err error
+		keys, err = auth.LoadKeys(ac.APIKeysFile)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
+
+	auditPath := ac.AuditLog
+	if auditPath == "" && (len(keys) > 0 || ac.AuthKey != "") {
+		auditPath = filepath.Join(installDir, "configs", "audit.log")
+	}
+
+	var auditLog *auth.AuditLog
+	if auditPath != "" {
+		var err error
+		auditLog, err = auth.OpenAuditLog(auditPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open audit log: %w", err)
+		}
+		log.Println("API requests are recorded to", auditPath)
+	}
+
+	return auth.NewAuthenticator(keys, ac.AuthKey, auditLog), auditLog, nil
 }
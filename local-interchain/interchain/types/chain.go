@@ -40,10 +40,20 @@ type Chain struct {
 
 	ConfigFileOverrides []ConfigFileOverrides `json:"config_file_overrides,omitempty"`
 
+	// Faucet enables the rate limited /faucet/<chain_id> endpoint (optional).
+	Faucet *Faucet `json:"faucet,omitempty"`
+
 	// EVM
 	EVMLoadStatePath string `json:"evm_load_state_path,omitempty"`
 }
 
+// Faucet dispenses coins from the genesis faucet account to any address, within per-address limits.
+type Faucet struct {
+	Amount        string `json:"amount"`          // coins sent per request, ex: 10000000%DENOM%
+	Interval      string `json:"interval"`        // minimum time between requests of an address, ex: 1m
+	MaxPerAddress int    `json:"max_per_address"` // total requests allowed per address, 0 for no limit
+}
+
 func (chain *Chain) Validate() error {
 	validate := validator.New()
 	return validate.Struct(chain)
@@ -89,6 +99,15 @@ func (chain *Chain) SetChainDefaults() {
 		chain.Genesis.Modify = []cosmos.GenesisKV{}
 	}
 
+	if chain.Faucet != nil {
+		if chain.Faucet.Amount == "" {
+			chain.Faucet.Amount = "10000000%DENOM%"
+		}
+		if chain.Faucet.Interval == "" {
+			chain.Faucet.Interval = "1m"
+		}
+	}
+
 	// TODO: Error here instead?
 	if chain.Binary == "" {
 		panic("'binary' is required in your config for " + chain.ChainID)
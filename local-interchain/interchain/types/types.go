@@ -16,6 +16,11 @@ type AppStartConfig struct {
 
 	Relayer Relayer
 	AuthKey string // optional password for API interaction
+
+	APIKeysFile string // optional file of scoped API keys
+	AuditLog    string // optional audit log path, defaults to configs/audit.log when authentication is enabled
+
+	FromSnapshot string // optional snapshot name to restore the environment from
 }
 
 type RestServer struct {
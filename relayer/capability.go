@@ -17,6 +17,12 @@ const (
 
 	// Whether the relayer supports a one-off flush command.
 	Flush
+
+	// Whether the relayer relays packets of ICS-29 fee enabled channels and collects their fees.
+	Fee
+
+	// Whether the relayer completes channel upgrade handshakes.
+	ChannelUpgrade
 )
 
 // FullCapabilities returns a mapping of all known relayer features to true,
@@ -29,5 +35,8 @@ func FullCapabilities() map[Capability]bool {
 		HeightTimeout:    true,
 
 		Flush: true,
+
+		Fee:            true,
+		ChannelUpgrade: true,
 	}
 }
@@ -11,11 +11,13 @@ func _() {
 	_ = x[TimestampTimeout-0]
 	_ = x[HeightTimeout-1]
 	_ = x[Flush-2]
+	_ = x[Fee-3]
+	_ = x[ChannelUpgrade-4]
 }
 
-const _Capability_name = "TimestampTimeoutHeightTimeoutFlush"
+const _Capability_name = "TimestampTimeoutHeightTimeoutFlushFeeChannelUpgrade"
 
-var _Capability_index = [...]uint8{0, 16, 29, 34}
+var _Capability_index = [...]uint8{0, 16, 29, 34, 37, 51}
 
 func (i Capability) String() string {
 	if i < 0 || i >= Capability(len(_Capability_index)-1) {
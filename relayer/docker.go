@@ -13,6 +13,7 @@ import (
 	volumetypes "github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
 	"go.uber.org/zap"
 
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
@@ -50,6 +51,9 @@ type DockerRelayer struct {
 	homeDir string
 
 	extraStartupFlags []string
+
+	// exposedPorts are the container ports published to the host when the relayer is started.
+	exposedPorts nat.PortMap
 }
 
 var _ ibc.Relayer = (*DockerRelayer)(nil)
@@ -246,6 +250,12 @@ func (r *DockerRelayer) Flush(ctx context.Context, rep ibc.RelayerExecReporter,
 	return res.Err
 }
 
+// UpgradeChannel is not supported by the relayers driven through the commander; implementations which support
+// channel upgrades override it.
+func (r *DockerRelayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	return fmt.Errorf("relayer %s does not support channel upgrades", r.c.Name())
+}
+
 func (r *DockerRelayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string) error {
 	cmd := r.c.GeneratePath(srcChainID, dstChainID, pathName, r.HomeDir())
 	res := r.Exec(ctx, rep, cmd, nil)
@@ -369,7 +379,7 @@ func (r *DockerRelayer) StartRelayer(ctx context.Context, rep ibc.RelayerExecRep
 	r.containerLifecycle = dockerutil.NewContainerLifecycle(r.log, r.client, containerName)
 
 	if err := r.containerLifecycle.CreateContainer(
-		ctx, r.testName, r.networkID, containerImage, nil,
+		ctx, r.testName, r.networkID, containerImage, r.exposedPorts,
 		r.Bind(), nil, r.HostName(joinedPaths), cmd, nil,
 	); err != nil {
 		return err
@@ -465,6 +475,14 @@ func (r *DockerRelayer) ResumeRelayer(ctx context.Context) error {
 	return r.client.ContainerUnpause(ctx, r.containerLifecycle.ContainerID())
 }
 
+// HostPorts returns the host addresses of the exposed container ports, such as "9090/tcp", of the running relayer.
+func (r *DockerRelayer) HostPorts(ctx context.Context, portIDs ...string) ([]string, error) {
+	if r.containerLifecycle == nil {
+		return nil, fmt.Errorf("relayer is not running")
+	}
+	return r.containerLifecycle.GetHostPorts(ctx, portIDs...)
+}
+
 func (r *DockerRelayer) ContainerImage() ibc.DockerImage {
 	if r.customImage != nil {
 		return *r.customImage
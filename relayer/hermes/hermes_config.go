@@ -62,25 +62,7 @@ func NewConfig(chainConfigs ...ChainConfig) Config {
 		Global: Global{
 			LogLevel: "info",
 		},
-		Mode: Mode{
-			Clients: Clients{
-				Enabled:      true,
-				Refresh:      true,
-				Misbehaviour: true,
-			},
-			Connections: Connections{
-				Enabled: true,
-			},
-			Channels: Channels{
-				Enabled: true,
-			},
-			Packets: Packets{
-				Enabled:        true,
-				ClearInterval:  0,
-				ClearOnStart:   true,
-				TxConfirmation: false,
-			},
-		},
+		Mode: DefaultMode(),
 		Rest: Rest{
 			Enabled: false,
 		},
@@ -94,6 +76,29 @@ func NewConfig(chainConfigs ...ChainConfig) Config {
 	}
 }
 
+// DefaultMode returns the mode section of the generated config, as a base for ConfigOptions.Mode.
+func DefaultMode() Mode {
+	return Mode{
+		Clients: Clients{
+			Enabled:      true,
+			Refresh:      true,
+			Misbehaviour: true,
+		},
+		Connections: Connections{
+			Enabled: true,
+		},
+		Channels: Channels{
+			Enabled: true,
+		},
+		Packets: Packets{
+			Enabled:        true,
+			ClearInterval:  0,
+			ClearOnStart:   true,
+			TxConfirmation: false,
+		},
+	}
+}
+
 type Config struct {
 	Global        Global        `toml:"global"`
 	Mode          Mode          `toml:"mode"`
@@ -168,6 +173,25 @@ type GasPrice struct {
 	Denom string  `toml:"denom"`
 }
 
+// PacketFilter restricts the channels relayed on a chain, and optionally the minimum ICS-29 fees of relayed packets.
+type PacketFilter struct {
+	// Policy is "allow" or "deny".
+	Policy string `toml:"policy"`
+	// List holds [port, channel] patterns, e.g. {"transfer", "channel-*"}.
+	List [][]string `toml:"list"`
+	// MinFees holds the minimum incentives of relayed packets, keyed by channel pattern.
+	MinFees map[string]MinFee `toml:"min_fees,omitempty"`
+}
+
+type MinFee struct {
+	Recv []FeeAmount `toml:"recv"`
+}
+
+type FeeAmount struct {
+	Amount uint64 `toml:"amount"`
+	Denom  string `toml:"denom"`
+}
+
 type TrustThreshold struct {
 	Numerator   string `toml:"numerator"`
 	Denominator string `toml:"denominator"`
@@ -197,4 +221,9 @@ type Chain struct {
 	TrustingPeriod   string         `toml:"trusting_period"`
 	TrustThreshold   TrustThreshold `toml:"trust_threshold"`
 	MemoPrefix       string         `toml:"memo_prefix,omitempty"`
+
+	PacketFilter      *PacketFilter       `toml:"packet_filter,omitempty"`
+	ExcludedSequences map[string][]uint64 `toml:"excluded_sequences,omitempty"`
+	ClientRefreshRate string              `toml:"client_refresh_rate,omitempty"`
+	SequentialBatchTx bool                `toml:"sequential_batch_tx"`
 }
@@ -0,0 +1,173 @@
+package hermes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+const (
+	telemetryPort     = 3001
+	telemetryPortName = "3001/tcp"
+)
+
+// ConfigOptions overrides sections of the generated hermes config. Nil sections keep their defaults.
+type ConfigOptions struct {
+	Global    *Global
+	Mode      *Mode
+	Telemetry *Telemetry
+
+	// Chains overrides settings of the chains, keyed by chain ID.
+	Chains map[string]ChainOptions
+}
+
+// ChainOptions overrides settings of a chain entry. Zero values keep the defaults.
+type ChainOptions struct {
+	PacketFilter *PacketFilter
+	// ExcludedSequences are the packet sequences not cleared by hermes, keyed by channel ID.
+	ExcludedSequences map[string][]uint64
+
+	ClientRefreshRate string
+	ClockDrift        string
+	MaxBlockTime      string
+	TrustingPeriod    string
+	TrustThreshold    *TrustThreshold
+	MemoPrefix        *string
+
+	DefaultGas        *int
+	MaxGas            *int
+	GasMultiplier     *float64
+	MaxMsgNum         *int
+	MaxTxSize         *int
+	SequentialBatchTx *bool
+}
+
+func (o ConfigOptions) apply(c *Config) {
+	if o.Global != nil {
+		c.Global = *o.Global
+	}
+	if o.Mode != nil {
+		c.Mode = *o.Mode
+	}
+	if o.Telemetry != nil {
+		c.Telemetry = *o.Telemetry
+	}
+	for i := range c.Chains {
+		if chainOpts, ok := o.Chains[c.Chains[i].ID]; ok {
+			chainOpts.apply(&c.Chains[i])
+		}
+	}
+}
+
+func (o ChainOptions) apply(c *Chain) {
+	if o.PacketFilter != nil {
+		c.PacketFilter = o.PacketFilter
+	}
+	if o.ExcludedSequences != nil {
+		c.ExcludedSequences = o.ExcludedSequences
+	}
+	if o.ClientRefreshRate != "" {
+		c.ClientRefreshRate = o.ClientRefreshRate
+	}
+	if o.ClockDrift != "" {
+		c.ClockDrift = o.ClockDrift
+	}
+	if o.MaxBlockTime != "" {
+		c.MaxBlockTime = o.MaxBlockTime
+	}
+	if o.TrustingPeriod != "" {
+		c.TrustingPeriod = o.TrustingPeriod
+	}
+	if o.TrustThreshold != nil {
+		c.TrustThreshold = *o.TrustThreshold
+	}
+	if o.MemoPrefix != nil {
+		c.MemoPrefix = *o.MemoPrefix
+	}
+	if o.DefaultGas != nil {
+		c.DefaultGas = *o.DefaultGas
+	}
+	if o.MaxGas != nil {
+		c.MaxGas = *o.MaxGas
+	}
+	if o.GasMultiplier != nil {
+		c.GasMultiplier = *o.GasMultiplier
+	}
+	if o.MaxMsgNum != nil {
+		c.MaxMsgNum = *o.MaxMsgNum
+	}
+	if o.MaxTxSize != nil {
+		c.MaxTxSize = *o.MaxTxSize
+	}
+	if o.SequentialBatchTx != nil {
+		c.SequentialBatchTx = *o.SequentialBatchTx
+	}
+}
+
+// SetConfigOptions overrides the generated config with opts. If chains were already added, the config file is
+// rewritten; the relayer must be restarted for a running relayer to pick up the changes.
+func (r *Relayer) SetConfigOptions(ctx context.Context, opts ConfigOptions) error {
+	r.configOptions = opts
+	if len(r.chainConfigs) == 0 {
+		return nil
+	}
+	return r.writeConfig(ctx, ibc.NopRelayerExecReporter{})
+}
+
+// EnableTelemetry enables the hermes telemetry server and publishes its port to the host, so it can be scraped
+// with Telemetry. It must be called before the relayer is started.
+func (r *Relayer) EnableTelemetry(ctx context.Context) error {
+	relayer.ExposedPorts(telemetryPortName)(r.DockerRelayer)
+
+	opts := r.configOptions
+	opts.Telemetry = &Telemetry{
+		Enabled: true,
+		Host:    "0.0.0.0",
+		Port:    telemetryPort,
+	}
+	return r.SetConfigOptions(ctx, opts)
+}
+
+// TelemetryMetrics are the metrics scraped from the hermes telemetry server.
+type TelemetryMetrics struct {
+	relayer.Metrics
+}
+
+// Telemetry scrapes the telemetry server of the running relayer, enabled with EnableTelemetry.
+func (r *Relayer) Telemetry(ctx context.Context) (TelemetryMetrics, error) {
+	ports, err := r.HostPorts(ctx, telemetryPortName)
+	if err != nil {
+		return TelemetryMetrics{}, err
+	}
+	if ports[0] == "" {
+		return TelemetryMetrics{}, fmt.Errorf("telemetry port is not exposed, call EnableTelemetry before starting the relayer")
+	}
+
+	metrics, err := relayer.ScrapeMetrics(ctx, fmt.Sprintf("http://%s/metrics", ports[0]))
+	if err != nil {
+		return TelemetryMetrics{}, err
+	}
+	return TelemetryMetrics{Metrics: metrics}, nil
+}
+
+// PendingPackets returns the number of packets sent from the chain which were not relayed yet.
+func (m TelemetryMetrics) PendingPackets(chainID string) float64 {
+	return m.Sum("backlog_size", map[string]string{"chain": chainID})
+}
+
+// MessagesSubmitted returns the number of messages submitted to the chain.
+func (m TelemetryMetrics) MessagesSubmitted(chainID string) float64 {
+	return m.Sum("total_messages_submitted_total", map[string]string{"chain": chainID})
+}
+
+// TxsSubmitted returns the number of transactions submitted to the chain.
+func (m TelemetryMetrics) TxsSubmitted(chainID string) float64 {
+	return m.Sum("tx_latency_submitted_count", map[string]string{"chain": chainID})
+}
+
+// Errors returns the number of transactions which failed to simulate or broadcast.
+func (m TelemetryMetrics) Errors() float64 {
+	return m.Sum("broadcast_errors_total", nil) + m.Sum("simulate_errors_total", nil)
+}
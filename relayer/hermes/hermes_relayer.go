@@ -18,7 +18,7 @@ import (
 const (
 	hermes                  = "hermes"
 	defaultContainerImage   = "ghcr.io/informalsystems/hermes"
-	DefaultContainerVersion = "v1.7.1"
+	DefaultContainerVersion = "v1.8.0" // first version supporting channel upgrades
 
 	hermesDefaultUidGid = "1000:1000"
 	hermesHome          = "/home/hermes"
@@ -35,8 +35,9 @@ var (
 // Relayer is the ibc.Relayer implementation for hermes.
 type Relayer struct {
 	*relayer.DockerRelayer
-	paths        map[string]*pathConfiguration
-	chainConfigs []ChainConfig
+	paths         map[string]*pathConfiguration
+	chainConfigs  []ChainConfig
+	configOptions ConfigOptions
 }
 
 // ChainConfig holds all values required to write an entry in the "chains" section in the hermes config file.
@@ -75,10 +76,27 @@ func NewHermesRelayer(log *zap.Logger, testName string, cli *client.Client, netw
 	}
 }
 
+// Capabilities returns the set of capabilities of the hermes relayer.
+// Channel upgrades require hermes v1.8 or later, so custom images must not be older.
+func Capabilities() map[relayer.Capability]bool {
+	return relayer.FullCapabilities()
+}
+
 // AddChainConfiguration is called once per chain configuration, which means that in the case of hermes, the single
 // config file is overwritten with a new entry each time this function is called.
 func (r *Relayer) AddChainConfiguration(ctx context.Context, rep ibc.RelayerExecReporter, chainConfig ibc.ChainConfig, keyName, rpcAddr, grpcAddr string) error {
-	configContent, err := r.configContent(chainConfig, keyName, rpcAddr, grpcAddr)
+	r.chainConfigs = append(r.chainConfigs, ChainConfig{
+		cfg:      chainConfig,
+		keyName:  keyName,
+		rpcAddr:  rpcAddr,
+		grpcAddr: grpcAddr,
+	})
+	return r.writeConfig(ctx, rep)
+}
+
+// writeConfig writes and validates the config file for all chains added so far.
+func (r *Relayer) writeConfig(ctx context.Context, rep ibc.RelayerExecReporter) error {
+	configContent, err := r.configContent()
 	if err != nil {
 		return fmt.Errorf("failed to generate config content: %w", err)
 	}
@@ -225,9 +243,9 @@ func (r *Relayer) CreateClient(ctx context.Context, rep ibc.RelayerExecReporter,
 	}
 
 	if pathConfig.chainA.chainID == srcChainID {
-		pathConfig.chainA.chainID = clientId
+		pathConfig.chainA.clientID = clientId
 	} else if pathConfig.chainB.chainID == srcChainID {
-		pathConfig.chainB.chainID = clientId
+		pathConfig.chainB.clientID = clientId
 	} else {
 		return fmt.Errorf("%s not found in path config", srcChainID)
 	}
@@ -268,6 +286,82 @@ func (r *Relayer) Flush(ctx context.Context, rep ibc.RelayerExecReporter, pathNa
 	return res.Err
 }
 
+// GetClients returns the clients hosted on the chain, along with their status.
+func (r *Relayer) GetClients(ctx context.Context, rep ibc.RelayerExecReporter, chainID string) (ibc.ClientOutputs, error) {
+	clients, err := r.DockerRelayer.GetClients(ctx, rep, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, client := range clients {
+		cmd := []string{hermes, "--json", "query", "client", "status", "--chain", chainID, "--client", client.ClientID}
+		res := r.Exec(ctx, rep, cmd, nil)
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		var status ClientStatusResult
+		if err := json.Unmarshal(extractJsonResult(res.Stdout), &status); err != nil {
+			return nil, fmt.Errorf("failed to parse status of client %s: %w", client.ClientID, err)
+		}
+		client.Status = status.Result
+	}
+	return clients, nil
+}
+
+// channelEnd identifies one end of a channel in the hermes tx commands.
+type channelEnd struct {
+	chainID, connectionID, portID, channelID string
+}
+
+// UpgradeChannel completes the handshake of a channel upgrade initialized on channelID of the path's chain A.
+// The try and confirm steps are submitted to chain B and the ack and open steps to chain A.
+// Requires hermes v1.8 or later.
+func (r *Relayer) UpgradeChannel(ctx context.Context, rep ibc.RelayerExecReporter, pathName, channelID string) error {
+	path, ok := r.paths[pathName]
+	if !ok {
+		return fmt.Errorf("path %s not found", pathName)
+	}
+
+	channels, err := r.GetChannels(ctx, rep, path.chainA.chainID)
+	if err != nil {
+		return err
+	}
+	var a, b channelEnd
+	for _, ch := range channels {
+		if ch.ChannelID != channelID {
+			continue
+		}
+		a = channelEnd{path.chainA.chainID, path.chainA.connectionID, ch.PortID, ch.ChannelID}
+		b = channelEnd{path.chainB.chainID, path.chainB.connectionID, ch.Counterparty.PortID, ch.Counterparty.ChannelID}
+	}
+	if a.channelID == "" {
+		return fmt.Errorf("channel %s not found on chain %s", channelID, path.chainA.chainID)
+	}
+
+	steps := []struct {
+		name     string
+		src, dst channelEnd
+	}{
+		{"chan-upgrade-try", a, b},
+		{"chan-upgrade-ack", b, a},
+		{"chan-upgrade-confirm", a, b},
+		{"chan-upgrade-open", b, a},
+	}
+	for _, step := range steps {
+		cmd := []string{hermes, "--json", "tx", step.name,
+			"--dst-chain", step.dst.chainID, "--src-chain", step.src.chainID,
+			"--dst-connection", step.dst.connectionID,
+			"--dst-port", step.dst.portID, "--src-port", step.src.portID,
+			"--dst-channel", step.dst.channelID, "--src-channel", step.src.channelID,
+		}
+		if res := r.Exec(ctx, rep, cmd, nil); res.Err != nil {
+			return fmt.Errorf("%s failed: %w", step.name, res.Err)
+		}
+	}
+	return nil
+}
+
 // GeneratePath establishes an in memory path representation. The concept does not exist in hermes, so it is handled
 // at the interchain test level.
 func (r *Relayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter, srcChainID, dstChainID, pathName string) error {
@@ -288,14 +382,9 @@ func (r *Relayer) GeneratePath(ctx context.Context, rep ibc.RelayerExecReporter,
 // configContent returns the contents of the hermes config file as a byte array. Note: as hermes expects a single file
 // rather than multiple config files, we need to maintain a list of chain configs each time they are added to write the
 // full correct file update calling Relayer.AddChainConfiguration.
-func (r *Relayer) configContent(cfg ibc.ChainConfig, keyName, rpcAddr, grpcAddr string) ([]byte, error) {
-	r.chainConfigs = append(r.chainConfigs, ChainConfig{
-		cfg:      cfg,
-		keyName:  keyName,
-		rpcAddr:  rpcAddr,
-		grpcAddr: grpcAddr,
-	})
+func (r *Relayer) configContent() ([]byte, error) {
 	hermesConfig := NewConfig(r.chainConfigs...)
+	r.configOptions.apply(&hermesConfig)
 	bz, err := toml.Marshal(hermesConfig)
 	if err != nil {
 		return nil, err
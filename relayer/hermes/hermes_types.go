@@ -107,3 +107,8 @@ type ClientResult struct {
 	ChainID  string `json:"chain_id"`
 	ClientID string `json:"client_id"`
 }
+
+// ClientStatusResult contains the status of a client, one of Active, Expired or Frozen.
+type ClientStatusResult struct {
+	Result string `json:"result"`
+}
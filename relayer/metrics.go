@@ -0,0 +1,109 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Metric is a single sample scraped from a relayer's Prometheus endpoint.
+// Histograms and summaries are flattened into their _sum and _count samples.
+type Metric struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Metrics are the samples of a single scrape.
+type Metrics []Metric
+
+// ScrapeMetrics fetches and parses the Prometheus text exposition served at url.
+func ScrapeMetrics(ctx context.Context, url string) (Metrics, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape metrics: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to scrape metrics: %s", resp.Status)
+	}
+	return ParseMetrics(resp.Body)
+}
+
+// ParseMetrics parses the Prometheus text exposition format.
+func ParseMetrics(r io.Reader) (Metrics, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	var metrics Metrics
+	for name, family := range families {
+		for _, m := range family.Metric {
+			labels := make(map[string]string, len(m.Label))
+			for _, l := range m.Label {
+				labels[l.GetName()] = l.GetValue()
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				metrics = append(metrics, Metric{Name: name, Labels: labels, Value: m.GetCounter().GetValue()})
+			case dto.MetricType_GAUGE:
+				metrics = append(metrics, Metric{Name: name, Labels: labels, Value: m.GetGauge().GetValue()})
+			case dto.MetricType_HISTOGRAM:
+				metrics = append(metrics,
+					Metric{Name: name + "_sum", Labels: labels, Value: m.GetHistogram().GetSampleSum()},
+					Metric{Name: name + "_count", Labels: labels, Value: float64(m.GetHistogram().GetSampleCount())},
+				)
+			case dto.MetricType_SUMMARY:
+				metrics = append(metrics,
+					Metric{Name: name + "_sum", Labels: labels, Value: m.GetSummary().GetSampleSum()},
+					Metric{Name: name + "_count", Labels: labels, Value: float64(m.GetSummary().GetSampleCount())},
+				)
+			default:
+				metrics = append(metrics, Metric{Name: name, Labels: labels, Value: m.GetUntyped().GetValue()})
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// Filter returns the samples of the metric name whose labels include all of the given labels.
+func (m Metrics) Filter(name string, labels map[string]string) Metrics {
+	var out Metrics
+	for _, metric := range m {
+		if metric.Name != name {
+			continue
+		}
+		match := true
+		for k, v := range labels {
+			if metric.Labels[k] != v {
+				match = false
+				break
+			}
+		}
+		if match {
+			out = append(out, metric)
+		}
+	}
+	return out
+}
+
+// Sum returns the sum of the samples of the metric name whose labels include all of the given labels.
+func (m Metrics) Sum(name string, labels map[string]string) float64 {
+	var sum float64
+	for _, metric := range m.Filter(name, labels) {
+		sum += metric.Value
+	}
+	return sum
+}
@@ -0,0 +1,38 @@
+package relayer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleMetrics = `# HELP backlog_size Total number of SendPacket events in the backlog
+# TYPE backlog_size gauge
+backlog_size{chain="chain-a",channel="channel-0",counterparty="chain-b",port="transfer"} 3
+backlog_size{chain="chain-a",channel="channel-1",counterparty="chain-c",port="transfer"} 2
+backlog_size{chain="chain-b",channel="channel-0",counterparty="chain-a",port="transfer"} 1
+# HELP total_messages_submitted_total Number of messages submitted
+# TYPE total_messages_submitted_total counter
+total_messages_submitted_total{account="cosmos1abc",chain="chain-a"} 7
+# HELP tx_latency_submitted Latency of submitted transactions
+# TYPE tx_latency_submitted histogram
+tx_latency_submitted_bucket{chain="chain-a",le="500"} 1
+tx_latency_submitted_bucket{chain="chain-a",le="+Inf"} 4
+tx_latency_submitted_sum{chain="chain-a"} 2500
+tx_latency_submitted_count{chain="chain-a"} 4
+`
+
+func TestParseMetrics(t *testing.T) {
+	metrics, err := relayer.ParseMetrics(strings.NewReader(sampleMetrics))
+	require.NoError(t, err)
+
+	require.Equal(t, float64(6), metrics.Sum("backlog_size", nil))
+	require.Equal(t, float64(5), metrics.Sum("backlog_size", map[string]string{"chain": "chain-a"}))
+	require.Len(t, metrics.Filter("backlog_size", map[string]string{"chain": "chain-a", "channel": "channel-1"}), 1)
+	require.Equal(t, float64(7), metrics.Sum("total_messages_submitted_total", map[string]string{"chain": "chain-a"}))
+	require.Equal(t, float64(4), metrics.Sum("tx_latency_submitted_count", map[string]string{"chain": "chain-a"}))
+	require.Equal(t, float64(2500), metrics.Sum("tx_latency_submitted_sum", nil))
+	require.Zero(t, metrics.Sum("backlog_size", map[string]string{"chain": "chain-z"}))
+}
@@ -1,6 +1,7 @@
 package relayer
 
 import (
+	"github.com/docker/go-connections/nat"
 	"github.com/strangelove-ventures/interchaintest/v8/ibc"
 )
 
@@ -45,3 +46,24 @@ func StartupFlags(flags ...string) RelayerOpt {
 		r.extraStartupFlags = flags
 	}
 }
+
+// ExposedPorts publishes the container ports, such as "9090/tcp", to the host when the relayer is started.
+// The host addresses are returned by DockerRelayer.HostPorts.
+func ExposedPorts(portIDs ...string) RelayerOpt {
+	return func(r *DockerRelayer) {
+		if r.exposedPorts == nil {
+			r.exposedPorts = nat.PortMap{}
+		}
+		for _, p := range portIDs {
+			r.exposedPorts[nat.Port(p)] = []nat.PortBinding{}
+		}
+	}
+}
+
+// AdditionalStartupFlags appends flags to the relayer startup flags, unlike StartupFlags it can be combined
+// with other options setting startup flags.
+func AdditionalStartupFlags(flags ...string) RelayerOpt {
+	return func(r *DockerRelayer) {
+		r.extraStartupFlags = append(r.extraStartupFlags, flags...)
+	}
+}
@@ -0,0 +1,55 @@
+package rly
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+// Metrics are the metrics scraped from the relayer's metrics server.
+type Metrics struct {
+	relayer.Metrics
+}
+
+// Metrics scrapes the metrics server of the running relayer, enabled with the MetricsServer option.
+func (r *CosmosRelayer) Metrics(ctx context.Context) (Metrics, error) {
+	ports, err := r.HostPorts(ctx, metricsPortName)
+	if err != nil {
+		return Metrics{}, err
+	}
+	if ports[0] == "" {
+		return Metrics{}, fmt.Errorf("metrics port is not exposed, start the relayer with the MetricsServer option")
+	}
+
+	metrics, err := relayer.ScrapeMetrics(ctx, fmt.Sprintf("http://%s/relayer/metrics", ports[0]))
+	if err != nil {
+		return Metrics{}, err
+	}
+	return Metrics{Metrics: metrics}, nil
+}
+
+// RelayedPackets returns the number of packets, acknowledgements and timeouts relayed to the chain.
+func (m Metrics) RelayedPackets(chainID string) float64 {
+	return m.Sum("cosmos_relayer_relayed_packets_total", map[string]string{"chain": chainID})
+}
+
+// ObservedPackets returns the number of packet events observed on the chain.
+func (m Metrics) ObservedPackets(chainID string) float64 {
+	return m.Sum("cosmos_relayer_observed_packets_total", map[string]string{"chain": chainID})
+}
+
+// TxFailures returns the number of transactions to the chain which failed.
+func (m Metrics) TxFailures(chainID string) float64 {
+	return m.Sum("cosmos_relayer_tx_failure_total", map[string]string{"chain": chainID})
+}
+
+// WalletBalance returns the balance of the relayer's wallet on the chain, in denom.
+func (m Metrics) WalletBalance(chainID, denom string) float64 {
+	return m.Sum("cosmos_relayer_wallet_balance", map[string]string{"chain": chainID, "denom": denom})
+}
+
+// FeeSpent returns the fees paid by the relayer's wallet on the chain, in denom.
+func (m Metrics) FeeSpent(chainID, denom string) float64 {
+	return m.Sum("cosmos_relayer_fee_spent", map[string]string{"chain": chainID, "denom": denom})
+}
@@ -0,0 +1,53 @@
+package rly
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+)
+
+const (
+	// ProcessorEvents relays by processing block events, the default.
+	ProcessorEvents = "events"
+	// ProcessorLegacy relays by querying for pending packets.
+	ProcessorLegacy = "legacy"
+
+	metricsPort     = "5184"
+	metricsPortName = metricsPort + "/tcp"
+)
+
+// Processor sets the relayer's packet processor, ProcessorEvents or ProcessorLegacy.
+func Processor(processor string) relayer.RelayerOpt {
+	return relayer.AdditionalStartupFlags("--processor", processor)
+}
+
+// BlockHistory sets how many blocks the events processor looks back on startup.
+func BlockHistory(blocks uint64) relayer.RelayerOpt {
+	return relayer.AdditionalStartupFlags("--block-history", strconv.FormatUint(blocks, 10))
+}
+
+// ClientUpdateThreshold refreshes light clients when they have not been updated within threshold.
+// A zero threshold only refreshes clients close to expiring.
+func ClientUpdateThreshold(threshold time.Duration) relayer.RelayerOpt {
+	return relayer.AdditionalStartupFlags("--time-threshold", threshold.String())
+}
+
+// MaxMsgs sets the maximum number of messages per transaction.
+func MaxMsgs(n uint64) relayer.RelayerOpt {
+	return relayer.AdditionalStartupFlags("--max-msgs", strconv.FormatUint(n, 10))
+}
+
+// FlushInterval sets how often the relayer flushes packets which were missed, a zero interval disables flushing.
+func FlushInterval(interval time.Duration) relayer.RelayerOpt {
+	return relayer.AdditionalStartupFlags("--flush-interval", interval.String())
+}
+
+// MetricsServer enables the relayer's Prometheus metrics server and publishes its port to the host,
+// so it can be scraped with CosmosRelayer.Metrics.
+func MetricsServer() relayer.RelayerOpt {
+	return func(r *relayer.DockerRelayer) {
+		relayer.AdditionalStartupFlags("--enable-metrics-server", "--metrics-listen-addr", "0.0.0.0:"+metricsPort)(r)
+		relayer.ExposedPorts(metricsPortName)(r)
+	}
+}
@@ -0,0 +1,29 @@
+package rly_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/relayer/rly"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartupOptions(t *testing.T) {
+	r := &relayer.DockerRelayer{}
+	for _, opt := range []relayer.RelayerOpt{
+		rly.Processor(rly.ProcessorLegacy),
+		rly.BlockHistory(100),
+		rly.ClientUpdateThreshold(time.Minute),
+		rly.MetricsServer(),
+	} {
+		opt(r)
+	}
+
+	require.Equal(t, []string{
+		"--processor", "legacy",
+		"--block-history", "100",
+		"--time-threshold", "1m0s",
+		"--enable-metrics-server", "--metrics-listen-addr", "0.0.0.0:5184",
+	}, r.GetExtraStartupFlags())
+}
@@ -0,0 +1,266 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/blockdb"
+	"go.uber.org/zap"
+)
+
+// RelayerWallet returns the wallet of the relayer on the chain, created during Build.
+func (ic *Interchain) RelayerWallet(r ibc.Relayer, c ibc.Chain) (ibc.Wallet, bool) {
+	w, ok := ic.relayerWallets[relayerChain{R: r, C: c}]
+	return w, ok
+}
+
+// RelayerWalletSpend is how much a relayer wallet spent on a chain since its tracking started, in the chain's denom.
+type RelayerWalletSpend struct {
+	Relayer string
+	ChainID string
+	Address string
+	Denom   string
+
+	StartBalance math.Int
+	Balance      math.Int
+	// ToppedUp is the amount sent to the wallet by TopUp.
+	ToppedUp math.Int
+	// Spent is the decrease of the balance, accounting for top ups.
+	Spent math.Int
+
+	// Fees and Txs are the fees paid and transactions signed by the wallet, from the fee events of the
+	// chain's blocks. They are only reported for chains whose transactions can be searched, such as cosmos chains.
+	Fees math.Int
+	Txs  int
+}
+
+// RelayerWalletTracker tracks the balances of the relayer wallets of an Interchain.
+type RelayerWalletTracker struct {
+	ic      *Interchain
+	wallets []*trackedRelayerWallet
+
+	mu sync.Mutex
+}
+
+type trackedRelayerWallet struct {
+	relayer      string
+	chain        ibc.Chain
+	address      string
+	startHeight  int64
+	startBalance math.Int
+	toppedUp     math.Int
+}
+
+// TrackRelayerWallets records the current balance of every relayer wallet, as the baseline of the spends
+// reported by the returned tracker. It must be called after Build.
+func (ic *Interchain) TrackRelayerWallets(ctx context.Context) (*RelayerWalletTracker, error) {
+	if !ic.built {
+		return nil, fmt.Errorf("Interchain.TrackRelayerWallets called before Build")
+	}
+
+	t := &RelayerWalletTracker{ic: ic}
+	for rc, wallet := range ic.relayerWallets {
+		height, err := rc.C.Height(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get height of chain %s: %w", ic.chains[rc.C], err)
+		}
+		address := wallet.FormattedAddress()
+		balance, err := rc.C.GetBalance(ctx, address, rc.C.Config().Denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relayer %s balance on chain %s: %w", ic.relayers[rc.R], ic.chains[rc.C], err)
+		}
+
+		t.wallets = append(t.wallets, &trackedRelayerWallet{
+			relayer:      ic.relayers[rc.R],
+			chain:        rc.C,
+			address:      address,
+			startHeight:  height,
+			startBalance: balance,
+			toppedUp:     math.ZeroInt(),
+		})
+	}
+
+	sort.Slice(t.wallets, func(i, j int) bool {
+		if t.wallets[i].relayer != t.wallets[j].relayer {
+			return t.wallets[i].relayer < t.wallets[j].relayer
+		}
+		return ic.chains[t.wallets[i].chain] < ic.chains[t.wallets[j].chain]
+	})
+
+	return t, nil
+}
+
+// Report returns the spend of every tracked relayer wallet, ordered by relayer name and chain ID.
+func (t *RelayerWalletTracker) Report(ctx context.Context) ([]RelayerWalletSpend, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	spends := make([]RelayerWalletSpend, 0, len(t.wallets))
+	for _, w := range t.wallets {
+		denom := w.chain.Config().Denom
+		balance, err := w.chain.GetBalance(ctx, w.address, denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relayer %s balance on chain %s: %w", w.relayer, t.ic.chains[w.chain], err)
+		}
+
+		spend := RelayerWalletSpend{
+			Relayer:      w.relayer,
+			ChainID:      t.ic.chains[w.chain],
+			Address:      w.address,
+			Denom:        denom,
+			StartBalance: w.startBalance,
+			Balance:      balance,
+			ToppedUp:     w.toppedUp,
+			Spent:        w.startBalance.Add(w.toppedUp).Sub(balance),
+			Fees:         math.ZeroInt(),
+		}
+
+		if finder, ok := w.chain.(blockdb.TxFinder); ok {
+			height, err := w.chain.Height(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for h := w.startHeight + 1; h <= height; h++ {
+				txs, err := finder.FindTxs(ctx, h)
+				if err != nil {
+					return nil, fmt.Errorf("failed to find txs of chain %s at height %d: %w", spend.ChainID, h, err)
+				}
+				fees, n := feesPaidBy(txs, w.address, denom)
+				spend.Fees = spend.Fees.Add(fees)
+				spend.Txs += n
+			}
+		}
+
+		spends = append(spends, spend)
+	}
+
+	return spends, nil
+}
+
+// feesPaidBy sums the fees in denom of the transactions whose fee payer is address.
+func feesPaidBy(txs []blockdb.Tx, address, denom string) (math.Int, int) {
+	total, n := math.ZeroInt(), 0
+	for _, tx := range txs {
+		for _, e := range tx.Events {
+			if e.Type != "tx" {
+				continue
+			}
+
+			var fee, payer string
+			for _, attr := range e.Attributes {
+				switch attr.Key {
+				case "fee":
+					fee = attr.Value
+				case "fee_payer":
+					payer = attr.Value
+				}
+			}
+			if payer != address {
+				continue
+			}
+
+			n++
+			coins, err := sdk.ParseCoinsNormalized(fee)
+			if err != nil {
+				continue
+			}
+			total = total.Add(coins.AmountOf(denom))
+		}
+	}
+	return total, n
+}
+
+// CheckFunds returns an error naming every tracked relayer wallet whose balance is below min.
+func (t *RelayerWalletTracker) CheckFunds(ctx context.Context, min math.Int) error {
+	low, err := t.lowWallets(ctx, min)
+	if err != nil {
+		return err
+	}
+	if len(low) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(low))
+	for i, w := range low {
+		msgs[i] = fmt.Sprintf("relayer %s on chain %s", w.relayer, t.ic.chains[w.chain])
+	}
+	return fmt.Errorf("relayer wallets below %s: %s", min, strings.Join(msgs, ", "))
+}
+
+// TopUp sends amount from the chain's faucet to every tracked relayer wallet whose balance is below min.
+func (t *RelayerWalletTracker) TopUp(ctx context.Context, min, amount math.Int) error {
+	low, err := t.lowWallets(ctx, min)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range low {
+		if err := w.chain.SendFunds(ctx, FaucetAccountKeyName, ibc.WalletAmount{
+			Address: w.address,
+			Denom:   w.chain.Config().Denom,
+			Amount:  amount,
+		}); err != nil {
+			return fmt.Errorf("failed to top up relayer %s on chain %s: %w", w.relayer, t.ic.chains[w.chain], err)
+		}
+
+		t.mu.Lock()
+		w.toppedUp = w.toppedUp.Add(amount)
+		t.mu.Unlock()
+
+		t.ic.log.Info("Topped up relayer wallet",
+			zap.String("relayer", w.relayer),
+			zap.String("chain_id", t.ic.chains[w.chain]),
+			zap.String("amount", amount.String()),
+		)
+	}
+	return nil
+}
+
+// AutoTopUp calls TopUp every interval until ctx is done or the returned stop function is called.
+// Top up errors are logged.
+func (t *RelayerWalletTracker) AutoTopUp(ctx context.Context, interval time.Duration, min, amount math.Int) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := t.TopUp(ctx, min, amount); err != nil && ctx.Err() == nil {
+					t.ic.log.Warn("Failed to top up relayer wallets", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (t *RelayerWalletTracker) lowWallets(ctx context.Context, min math.Int) ([]*trackedRelayerWallet, error) {
+	var low []*trackedRelayerWallet
+	for _, w := range t.wallets {
+		balance, err := w.chain.GetBalance(ctx, w.address, w.chain.Config().Denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relayer %s balance on chain %s: %w", w.relayer, t.ic.chains[w.chain], err)
+		}
+		if balance.LT(min) {
+			low = append(low, w)
+		}
+	}
+	return low, nil
+}
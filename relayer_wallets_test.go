@@ -0,0 +1,29 @@
+package interchaintest
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v8/internal/blockdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeesPaidBy(t *testing.T) {
+	feeEvent := func(fee, payer string) blockdb.Event {
+		return blockdb.Event{Type: "tx", Attributes: []blockdb.EventAttribute{
+			{Key: "fee", Value: fee},
+			{Key: "fee_payer", Value: payer},
+		}}
+	}
+
+	txs := []blockdb.Tx{
+		{Events: []blockdb.Event{feeEvent("2500uatom", "cosmos1relayer"), {Type: "message"}}},
+		{Events: []blockdb.Event{feeEvent("100uatom,7ufoo", "cosmos1relayer")}},
+		{Events: []blockdb.Event{feeEvent("900uatom", "cosmos1other")}},
+		{Events: []blockdb.Event{feeEvent("", "cosmos1relayer")}},
+	}
+
+	fees, n := feesPaidBy(txs, "cosmos1relayer", "uatom")
+	require.Equal(t, math.NewInt(2600), fees)
+	require.Equal(t, 3, n)
+}
@@ -0,0 +1,101 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+)
+
+// MultiHopOptions are the options of a transfer forwarded through intermediate chains.
+type MultiHopOptions struct {
+	// Transfer are the options of the transfer sent by the first chain. Its memo is replaced by the forward memo.
+	Transfer ibc.TransferOptions
+	// Timeout and Retries of the forwarded packets, see ibc.Hop.
+	Timeout time.Duration
+	Retries *uint8
+}
+
+// MultiHopTransfer is the outcome of a transfer forwarded through intermediate chains.
+type MultiHopTransfer struct {
+	// Tx is the transfer sent by the first chain.
+	Tx ibc.Tx
+	// Ack is the acknowledgement of Tx, written once the last chain acknowledged the forwarded packet.
+	Ack ibc.PacketAcknowledgement
+	// Denom is the denom received on the last chain.
+	Denom string
+	// Refunded is true if a hop failed and the tokens were refunded to the sender on the first chain.
+	Refunded bool
+}
+
+// SendMultiHopTransfer sends amount from keyName on chains[0] to amount.Address on the last chain, forwarded through
+// the chains in between by the packet forward middleware. channels[i] is the channel end on chains[i] to chains[i+1].
+// The relayers of all hops must be running. It polls up to maxBlocks blocks of the first chain for the
+// acknowledgement, then checks the last chain received the tokens unless the transfer was refunded.
+func SendMultiHopTransfer(
+	ctx context.Context,
+	chains []ibc.Chain,
+	channels []ibc.ChannelOutput,
+	keyName string,
+	amount ibc.WalletAmount,
+	maxBlocks int64,
+	opts MultiHopOptions,
+) (MultiHopTransfer, error) {
+	var zero MultiHopTransfer
+	if len(chains) < 2 || len(channels) != len(chains)-1 {
+		return zero, fmt.Errorf("expected one channel per hop, got %d chains and %d channels", len(chains), len(channels))
+	}
+
+	dst := chains[len(chains)-1]
+	denom := ibc.TransferDenomTrace(amount.Denom, channels...).IBCDenom()
+	startBalance, err := dst.GetBalance(ctx, amount.Address, denom)
+	if err != nil {
+		return zero, fmt.Errorf("failed to get balance on %s: %w", dst.Config().ChainID, err)
+	}
+
+	hops := make([]ibc.Hop, len(channels)-1)
+	for i, channel := range channels[1:] {
+		hops[i] = ibc.Hop{
+			PortID:    channel.PortID,
+			ChannelID: channel.ChannelID,
+			Timeout:   opts.Timeout,
+			Retries:   opts.Retries,
+		}
+	}
+	transfer := amount
+	if len(hops) > 0 {
+		hops[len(hops)-1].Receiver = amount.Address
+		transfer.Address = ibc.ForwardIntermediateReceiver
+	}
+
+	transferOpts := opts.Transfer
+	transferOpts.Memo, err = ibc.NewPacketMetadata(hops...).Memo()
+	if err != nil {
+		return zero, fmt.Errorf("failed to build forward memo: %w", err)
+	}
+
+	src := chains[0]
+	tx, err := src.SendIBCTransfer(ctx, channels[0].ChannelID, keyName, transfer, transferOpts)
+	if err != nil {
+		return zero, fmt.Errorf("failed to send transfer on %s: %w", src.Config().ChainID, err)
+	}
+
+	ack, err := PollForAck(ctx, src, tx.Height, tx.Height+maxBlocks, tx.Packet)
+	if err != nil {
+		return zero, fmt.Errorf("failed to get acknowledgement of transfer: %w", err)
+	}
+	res := MultiHopTransfer{Tx: tx, Ack: ack, Denom: denom, Refunded: !ack.Success()}
+	if res.Refunded {
+		return res, nil
+	}
+
+	balance, err := dst.GetBalance(ctx, amount.Address, denom)
+	if err != nil {
+		return zero, fmt.Errorf("failed to get balance on %s: %w", dst.Config().ChainID, err)
+	}
+	if received := balance.Sub(startBalance); !received.Equal(amount.Amount) {
+		return res, fmt.Errorf("expected %s%s received on %s, got %s", amount.Amount, denom, dst.Config().ChainID, received)
+	}
+	return res, nil
+}